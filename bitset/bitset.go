@@ -0,0 +1,226 @@
+// Package bitset provides sets of non-negative integers stored as bits:
+// BitSet for small, dense values such as permission masks and feature flags,
+// and Sparse for values spread over a large range.
+package bitset
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+	"github.com/flowonyx/functional/set"
+)
+
+const wordSize = 64
+
+// BitSet is a set of non-negative integers with one bit for each integer from 0 to the largest in the set.
+// Membership tests take constant time and set operations work on 64 values at a time.
+// The zero value is an empty BitSet that is ready to use.
+type BitSet struct {
+	words []uint64
+}
+
+// New creates a BitSet holding values.
+// It panics if any value is negative.
+func New(values ...int) BitSet {
+	var b BitSet
+	for _, v := range values {
+		b.Add(v)
+	}
+	return b
+}
+
+// FromSlice creates a BitSet holding the values in the slice.
+// It panics if any value is negative.
+func FromSlice(values []int) BitSet {
+	return New(values...)
+}
+
+// FromSet creates a BitSet holding the values in s.
+// It panics if any value is negative.
+func FromSet(s set.Set[int]) BitSet {
+	return New(s.Items()...)
+}
+
+func checkValue(op string, value int) {
+	if value < 0 {
+		panic(errors.BadArgument(op, "value", "must not be negative").With("value", value))
+	}
+}
+
+// Add adds value to the BitSet.
+// It panics if value is negative.
+func (b *BitSet) Add(value int) {
+	checkValue("BitSet.Add", value)
+	w := value / wordSize
+	if w >= len(b.words) {
+		b.words = append(b.words, make([]uint64, w+1-len(b.words))...)
+	}
+	b.words[w] |= 1 << (value % wordSize)
+}
+
+// Remove removes value from the BitSet.
+func (b *BitSet) Remove(value int) {
+	if !b.Contains(value) {
+		return
+	}
+	b.words[value/wordSize] &^= 1 << (value % wordSize)
+	b.trim()
+}
+
+// Clear removes every value.
+func (b *BitSet) Clear() {
+	b.words = nil
+}
+
+// trim drops the zero words at the end so that equal sets have equal words.
+func (b *BitSet) trim() {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+	b.words = b.words[:n]
+}
+
+// Clone returns a copy of the BitSet that does not share its storage.
+func (b BitSet) Clone() BitSet {
+	return BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// Contains tests whether value is in the BitSet.
+func (b BitSet) Contains(value int) bool {
+	return value >= 0 && value/wordSize < len(b.words) && b.words[value/wordSize]&(1<<(value%wordSize)) != 0
+}
+
+// Count returns the number of values in the BitSet.
+func (b BitSet) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// IsEmpty tests whether the BitSet has no values.
+func (b BitSet) IsEmpty() bool {
+	return len(b.words) == 0
+}
+
+// Equal tests whether two BitSets hold the same values.
+func (b BitSet) Equal(other BitSet) bool {
+	if len(b.words) != len(other.words) {
+		return false
+	}
+	for i, w := range b.words {
+		if w != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSubsetOf tests whether every value in the BitSet is also in other.
+func (b BitSet) IsSubsetOf(other BitSet) bool {
+	return b.Difference(other).IsEmpty()
+}
+
+// combine applies op to the words of both BitSets, treating missing words as zero.
+func (b BitSet) combine(other BitSet, op func(a, b uint64) uint64) BitSet {
+	output := BitSet{words: make([]uint64, max(len(b.words), len(other.words)))}
+	for i := range output.words {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		output.words[i] = op(x, y)
+	}
+	output.trim()
+	return output
+}
+
+// Union returns the values that are in either BitSet.
+func (b BitSet) Union(other BitSet) BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Intersect returns the values that are in both BitSets.
+func (b BitSet) Intersect(other BitSet) BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// Difference returns the values that are in the BitSet but not in other.
+func (b BitSet) Difference(other BitSet) BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// SymmetricDifference returns the values that are in one BitSet but not both.
+func (b BitSet) SymmetricDifference(other BitSet) BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// NextSet returns the smallest value in the BitSet that is at least from, or None if there is none.
+func (b BitSet) NextSet(from int) option.Option[int] {
+	from = max(from, 0)
+	w := from / wordSize
+	if w >= len(b.words) {
+		return option.None[int]()
+	}
+	// Ignore the bits below from in its word.
+	word := b.words[w] >> (from % wordSize) << (from % wordSize)
+	for {
+		if word != 0 {
+			return option.Some(w*wordSize + bits.TrailingZeros64(word))
+		}
+		w++
+		if w >= len(b.words) {
+			return option.None[int]()
+		}
+		word = b.words[w]
+	}
+}
+
+// Iter applies action to each value in ascending order.
+func (b BitSet) Iter(action func(int)) {
+	for w, word := range b.words {
+		for word != 0 {
+			action(w*wordSize + bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+}
+
+// ToSlice returns the values in ascending order.
+func (b BitSet) ToSlice() []int {
+	output := make([]int, 0, b.Count())
+	b.Iter(func(v int) { output = append(output, v) })
+	return output
+}
+
+// ToSet returns the values as a set.Set that keeps them in ascending order.
+func (b BitSet) ToSet() set.Set[int] {
+	return set.FromSlice(b.ToSlice(), cmp.Compare[int])
+}
+
+// String formats the values in ascending order, such as {1 3 5}.
+func (b BitSet) String() string {
+	return formatValues(b.ToSlice())
+}
+
+func formatValues(values []int) string {
+	var s strings.Builder
+	s.WriteString("{")
+	for i, v := range values {
+		if i > 0 {
+			s.WriteString(" ")
+		}
+		fmt.Fprint(&s, v)
+	}
+	s.WriteString("}")
+	return s.String()
+}
@@ -0,0 +1,67 @@
+package bitset_test
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/flowonyx/functional/bitset"
+	"github.com/flowonyx/functional/set"
+)
+
+const (
+	read = iota
+	write
+	execute
+	admin
+)
+
+func ExampleBitSet() {
+	perms := bitset.New(read, write)
+	perms.Add(execute)
+	perms.Remove(write)
+	fmt.Println(perms, perms.Count(), perms.Contains(read), perms.Contains(write))
+	fmt.Println(bitset.New(read).IsSubsetOf(perms), perms.Equal(bitset.New(execute, read)))
+	// Output:
+	// {0 2} 2 true false
+	// true true
+}
+
+func ExampleBitSet_Union() {
+	a, b := bitset.New(1, 2, 3, 100), bitset.New(3, 4, 100)
+	fmt.Println(a.Union(b))
+	fmt.Println(a.Intersect(b))
+	fmt.Println(a.Difference(b))
+	fmt.Println(a.SymmetricDifference(b))
+	// Output:
+	// {1 2 3 4 100}
+	// {3 100}
+	// {1 2}
+	// {1 2 4}
+}
+
+func ExampleBitSet_NextSet() {
+	b := bitset.New(3, 64, 200)
+	for v := b.NextSet(0); v.IsSome(); v = b.NextSet(v.Value() + 1) {
+		fmt.Println(v.Value())
+	}
+	fmt.Println(b.NextSet(201))
+	// Output:
+	// 3
+	// 64
+	// 200
+	// None
+}
+
+func ExampleFromSet() {
+	s := set.FromSlice([]int{5, 1, 3}, cmp.Compare[int])
+	b := bitset.FromSet(s)
+	fmt.Println(b, b.ToSlice(), b.ToSet().Equal(s))
+	// Output: {1 3 5} [1 3 5] true
+}
+
+func ExampleBitSet_Add() {
+	defer func() { fmt.Println(recover()) }()
+	var b bitset.BitSet
+	b.Add(-1)
+	// Output: bad argument: BitSet.Add(arg=value, value=-1): must not be negative
+}
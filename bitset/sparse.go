@@ -0,0 +1,355 @@
+package bitset
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+	"sort"
+
+	"github.com/flowonyx/functional/option"
+	"github.com/flowonyx/functional/set"
+)
+
+const (
+	// Each container holds the values that share everything but their lowest containerBits bits.
+	containerBits = 16
+	containerSize = 1 << containerBits
+	bitmapWords   = containerSize / wordSize
+	// arrayMax is the most values a container keeps as a sorted array.
+	// Above it a bitmap, which always takes 8KiB, is smaller.
+	arrayMax = 4096
+)
+
+// container holds the low bits of the values in one block of containerSize values,
+// either as a sorted array or, when there are many, as a bitmap.
+type container struct {
+	key    int
+	array  []uint16
+	bitmap []uint64
+	count  int
+}
+
+func (c *container) isBitmap() bool {
+	return c.bitmap != nil
+}
+
+func (c *container) contains(low uint16) bool {
+	if c.isBitmap() {
+		return c.bitmap[low/wordSize]&(1<<(low%wordSize)) != 0
+	}
+	_, found := slices.BinarySearch(c.array, low)
+	return found
+}
+
+func (c *container) add(low uint16) {
+	if c.contains(low) {
+		return
+	}
+	c.count++
+	if c.isBitmap() {
+		c.bitmap[low/wordSize] |= 1 << (low % wordSize)
+		return
+	}
+	i, _ := slices.BinarySearch(c.array, low)
+	c.array = slices.Insert(c.array, i, low)
+	if len(c.array) > arrayMax {
+		c.bitmap, c.array = c.words(), nil
+	}
+}
+
+func (c *container) remove(low uint16) {
+	if !c.contains(low) {
+		return
+	}
+	c.count--
+	if c.isBitmap() {
+		c.bitmap[low/wordSize] &^= 1 << (low % wordSize)
+		if c.count <= arrayMax {
+			*c = fromWords(c.key, c.bitmap)
+		}
+		return
+	}
+	i, _ := slices.BinarySearch(c.array, low)
+	c.array = slices.Delete(c.array, i, i+1)
+}
+
+// words returns the values of the container as a new bitmap.
+func (c *container) words() []uint64 {
+	if c.isBitmap() {
+		return slices.Clone(c.bitmap)
+	}
+	words := make([]uint64, bitmapWords)
+	for _, low := range c.array {
+		words[low/wordSize] |= 1 << (low % wordSize)
+	}
+	return words
+}
+
+// fromWords creates a container from a bitmap, using an array if there are few enough values.
+func fromWords(key int, words []uint64) container {
+	count := 0
+	for _, w := range words {
+		count += bits.OnesCount64(w)
+	}
+	if count > arrayMax {
+		return container{key: key, bitmap: words, count: count}
+	}
+	array := make([]uint16, 0, count)
+	for i, w := range words {
+		for w != 0 {
+			array = append(array, uint16(i*wordSize+bits.TrailingZeros64(w)))
+			w &= w - 1
+		}
+	}
+	return container{key: key, array: array, count: count}
+}
+
+func (c *container) clone() container {
+	return container{key: c.key, array: slices.Clone(c.array), bitmap: slices.Clone(c.bitmap), count: c.count}
+}
+
+// next returns the smallest low value in the container that is at least from.
+func (c *container) next(from int) option.Option[int] {
+	if !c.isBitmap() {
+		i := sort.Search(len(c.array), func(i int) bool { return int(c.array[i]) >= from })
+		if i < len(c.array) {
+			return option.Some(int(c.array[i]))
+		}
+		return option.None[int]()
+	}
+	return BitSet{words: c.bitmap}.NextSet(from)
+}
+
+func (c *container) iter(action func(int)) {
+	base := c.key << containerBits
+	if c.isBitmap() {
+		BitSet{words: c.bitmap}.Iter(func(low int) { action(base + low) })
+		return
+	}
+	for _, low := range c.array {
+		action(base + int(low))
+	}
+}
+
+// Sparse is a set of non-negative integers split into blocks of 65536 values that are each stored
+// as a sorted array when they hold few values or as a bitmap when they hold many,
+// in the way roaring bitmaps are. Blocks with no values take no space,
+// so it suits values spread over a large range where a BitSet would be mostly zeros.
+// The zero value is an empty Sparse that is ready to use.
+type Sparse struct {
+	containers []container
+}
+
+// NewSparse creates a Sparse holding values.
+// It panics if any value is negative.
+func NewSparse(values ...int) Sparse {
+	var s Sparse
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// SparseFromSet creates a Sparse holding the values in s.
+// It panics if any value is negative.
+func SparseFromSet(s set.Set[int]) Sparse {
+	return NewSparse(s.Items()...)
+}
+
+// ToSparse returns the values of the BitSet as a Sparse.
+func (b BitSet) ToSparse() Sparse {
+	var s Sparse
+	b.Iter(s.Add)
+	return s
+}
+
+// ToBitSet returns the values as a BitSet.
+func (s Sparse) ToBitSet() BitSet {
+	var b BitSet
+	s.Iter(b.Add)
+	return b
+}
+
+func split(value int) (key int, low uint16) {
+	return value >> containerBits, uint16(value & (containerSize - 1))
+}
+
+// find returns the index of the container with key, or the index to insert it at if there is none.
+func (s Sparse) find(key int) (int, bool) {
+	return slices.BinarySearchFunc(s.containers, key, func(c container, key int) int { return cmp.Compare(c.key, key) })
+}
+
+// Add adds value to the Sparse.
+// It panics if value is negative.
+func (s *Sparse) Add(value int) {
+	checkValue("Sparse.Add", value)
+	key, low := split(value)
+	i, found := s.find(key)
+	if !found {
+		s.containers = slices.Insert(s.containers, i, container{key: key})
+	}
+	s.containers[i].add(low)
+}
+
+// Remove removes value from the Sparse.
+func (s *Sparse) Remove(value int) {
+	if value < 0 {
+		return
+	}
+	key, low := split(value)
+	i, found := s.find(key)
+	if !found {
+		return
+	}
+	s.containers[i].remove(low)
+	if s.containers[i].count == 0 {
+		s.containers = slices.Delete(s.containers, i, i+1)
+	}
+}
+
+// Clear removes every value.
+func (s *Sparse) Clear() {
+	s.containers = nil
+}
+
+// Clone returns a copy of the Sparse that does not share its storage.
+func (s Sparse) Clone() Sparse {
+	output := Sparse{containers: make([]container, len(s.containers))}
+	for i := range s.containers {
+		output.containers[i] = s.containers[i].clone()
+	}
+	return output
+}
+
+// Contains tests whether value is in the Sparse.
+func (s Sparse) Contains(value int) bool {
+	if value < 0 {
+		return false
+	}
+	key, low := split(value)
+	i, found := s.find(key)
+	return found && s.containers[i].contains(low)
+}
+
+// Count returns the number of values in the Sparse.
+func (s Sparse) Count() int {
+	count := 0
+	for _, c := range s.containers {
+		count += c.count
+	}
+	return count
+}
+
+// IsEmpty tests whether the Sparse has no values.
+func (s Sparse) IsEmpty() bool {
+	return len(s.containers) == 0
+}
+
+// Equal tests whether two Sparse sets hold the same values.
+func (s Sparse) Equal(other Sparse) bool {
+	return slices.EqualFunc(s.containers, other.containers, func(a, b container) bool {
+		return a.key == b.key && a.count == b.count && slices.Equal(a.array, b.array) && slices.Equal(a.bitmap, b.bitmap)
+	})
+}
+
+// IsSubsetOf tests whether every value in the Sparse is also in other.
+func (s Sparse) IsSubsetOf(other Sparse) bool {
+	return s.Difference(other).IsEmpty()
+}
+
+// combine applies op to the bitmaps of the containers with the same key in both sets.
+// keepFirst and keepSecond tell whether a container that is only in the first or only in the second set is kept as it is.
+func (s Sparse) combine(other Sparse, op func(a, b uint64) uint64, keepFirst, keepSecond bool) Sparse {
+	var output Sparse
+	keep := func(c container) {
+		output.containers = append(output.containers, c.clone())
+	}
+	i, j := 0, 0
+	for i < len(s.containers) || j < len(other.containers) {
+		switch {
+		case j == len(other.containers) || (i < len(s.containers) && s.containers[i].key < other.containers[j].key):
+			if keepFirst {
+				keep(s.containers[i])
+			}
+			i++
+		case i == len(s.containers) || other.containers[j].key < s.containers[i].key:
+			if keepSecond {
+				keep(other.containers[j])
+			}
+			j++
+		default:
+			a, b := s.containers[i].words(), other.containers[j].words()
+			for k := range a {
+				a[k] = op(a[k], b[k])
+			}
+			if c := fromWords(s.containers[i].key, a); c.count > 0 {
+				output.containers = append(output.containers, c)
+			}
+			i++
+			j++
+		}
+	}
+	return output
+}
+
+// Union returns the values that are in either Sparse.
+func (s Sparse) Union(other Sparse) Sparse {
+	return s.combine(other, func(x, y uint64) uint64 { return x | y }, true, true)
+}
+
+// Intersect returns the values that are in both Sparse sets.
+func (s Sparse) Intersect(other Sparse) Sparse {
+	return s.combine(other, func(x, y uint64) uint64 { return x & y }, false, false)
+}
+
+// Difference returns the values that are in the Sparse but not in other.
+func (s Sparse) Difference(other Sparse) Sparse {
+	return s.combine(other, func(x, y uint64) uint64 { return x &^ y }, true, false)
+}
+
+// SymmetricDifference returns the values that are in one Sparse but not both.
+func (s Sparse) SymmetricDifference(other Sparse) Sparse {
+	return s.combine(other, func(x, y uint64) uint64 { return x ^ y }, true, true)
+}
+
+// NextSet returns the smallest value in the Sparse that is at least from, or None if there is none.
+func (s Sparse) NextSet(from int) option.Option[int] {
+	from = max(from, 0)
+	key, low := split(from)
+	i, found := s.find(key)
+	if found {
+		if next := s.containers[i].next(int(low)); next.IsSome() {
+			return option.Some(key<<containerBits + next.Value())
+		}
+		i++
+	}
+	if i < len(s.containers) {
+		c := s.containers[i]
+		return option.Some(c.key<<containerBits + c.next(0).Value())
+	}
+	return option.None[int]()
+}
+
+// Iter applies action to each value in ascending order.
+func (s Sparse) Iter(action func(int)) {
+	for i := range s.containers {
+		s.containers[i].iter(action)
+	}
+}
+
+// ToSlice returns the values in ascending order.
+func (s Sparse) ToSlice() []int {
+	output := make([]int, 0, s.Count())
+	s.Iter(func(v int) { output = append(output, v) })
+	return output
+}
+
+// ToSet returns the values as a set.Set that keeps them in ascending order.
+func (s Sparse) ToSet() set.Set[int] {
+	return set.FromSlice(s.ToSlice(), cmp.Compare[int])
+}
+
+// String formats the values in ascending order, such as {1 3 5}.
+func (s Sparse) String() string {
+	return formatValues(s.ToSlice())
+}
@@ -0,0 +1,66 @@
+package bitset_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/bitset"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+)
+
+func ExampleSparse() {
+	s := bitset.NewSparse(7, 1_000_000, 1_500_000_000)
+	fmt.Println(s, s.Count(), s.Contains(1_000_000))
+	fmt.Println(s.NextSet(8), s.NextSet(1_500_000_001))
+	fmt.Println(s.Intersect(bitset.NewSparse(7, 8, 1_500_000_000)))
+	fmt.Println(bitset.New(1, 2).ToSparse().Union(s).Count(), s.Difference(bitset.NewSparse(1_000_000, 1_500_000_000)).ToBitSet())
+	// Output:
+	// {7 1000000 1500000000} 3 true
+	// Some(1000000) None
+	// {7 1500000000}
+	// 5 {7}
+}
+
+// valueGen makes values in a few blocks with enough in each that some containers become bitmaps.
+func valueGen() prop.Gen[int] {
+	return prop.Map(func(p functional.Pair[int, int]) int { return p.First<<16 + p.Second }, prop.PairOf(prop.Int(0, 2), prop.Int(0, 6000)))
+}
+
+func denseGen() prop.Gen[[]int] {
+	return prop.Bind(prop.Int(0, 2), func(block int) prop.Gen[[]int] {
+		return prop.Map(func(n int) []int {
+			return list.Map(func(i int) int { return block<<16 + i*3 }, list.RangeTo(n))
+		}, prop.Int(0, 9000))
+	})
+}
+
+func TestSparseMatchesBitSet(t *testing.T) {
+	values := prop.OneOfGen(prop.SliceOf(valueGen()), denseGen())
+	ops := prop.TripleOf(values, values, prop.SliceOf(valueGen()))
+	prop.ForAll(t, ops, func(p functional.Triple[[]int, []int, []int]) bool {
+		sa, sb := bitset.NewSparse(p.First...), bitset.NewSparse(p.Second...)
+		ba, bb := bitset.New(p.First...), bitset.New(p.Second...)
+		for _, v := range p.Third {
+			sa.Remove(v)
+			ba.Remove(v)
+		}
+		same := func(s bitset.Sparse, b bitset.BitSet) bool {
+			return s.Count() == b.Count() && s.ToBitSet().Equal(b) && s.Equal(b.ToSparse())
+		}
+		if !same(sa, ba) || !same(sb, bb) ||
+			!same(sa.Union(sb), ba.Union(bb)) ||
+			!same(sa.Intersect(sb), ba.Intersect(bb)) ||
+			!same(sa.Difference(sb), ba.Difference(bb)) ||
+			!same(sa.SymmetricDifference(sb), ba.SymmetricDifference(bb)) {
+			return false
+		}
+		for _, v := range p.Third {
+			if sa.Contains(v) != ba.Contains(v) || sa.NextSet(v).String() != ba.NextSet(v).String() {
+				return false
+			}
+		}
+		return true
+	}, prop.Runs(50))
+}
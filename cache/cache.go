@@ -0,0 +1,192 @@
+// Package cache provides bounded caches that evict entries by recency (LRU), by frequency (LFU)
+// or by age (TTL). All of them are safe for concurrent use.
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+)
+
+// ComputePanickedErr is returned to callers of GetOrCompute that were waiting on a computation that panicked.
+const ComputePanickedErr = errors.FunctionalError("compute panicked")
+
+// Cache is the set of methods shared by LRU, LFU and TTL.
+type Cache[K comparable, V any] interface {
+	// Get returns the value of the key or the zero value of the value type if it is not present.
+	Get(key K) V
+	// TryGet returns Some(value) if the key is present, otherwise it returns None.
+	TryGet(key K) option.Option[V]
+	// Set adds or replaces the value of the key, evicting another entry if the cache is full.
+	Set(key K, value V)
+	// Remove removes the key and reports whether it was present.
+	Remove(key K) bool
+	// Contains tests whether the key is present without counting as a use of it.
+	Contains(key K) bool
+	// Len returns the number of entries.
+	Len() int
+	// Keys returns the keys, starting with the one that would be evicted last.
+	Keys() []K
+	// Clear removes every entry.
+	Clear()
+	// GetOrCompute returns the value of the key, calling compute to create it if it is not present.
+	GetOrCompute(key K, compute func() (V, error)) (V, error)
+	// Stats returns the hit, miss and eviction counts.
+	Stats() Stats
+}
+
+// Reason tells an eviction callback why an entry left the cache.
+type Reason int
+
+const (
+	// Capacity means the entry was evicted to make room for another.
+	Capacity Reason = iota
+	// Expired means the entry was older than its time to live.
+	Expired
+	// Removed means the entry was removed by Remove or Clear.
+	Removed
+)
+
+func (r Reason) String() string {
+	switch r {
+	case Capacity:
+		return "capacity"
+	case Expired:
+		return "expired"
+	case Removed:
+		return "removed"
+	}
+	return "unknown"
+}
+
+// Stats counts how a cache has been used.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRatio returns the fraction of lookups that found their key, or 0 if there have been no lookups.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Clock is the source of time for TTL. policy.ManualClock can be used in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+type config[K comparable, V any] struct {
+	onEvict func(K, V, Reason)
+	clock   Clock
+}
+
+// Option is an option for NewLRU, NewLFU and NewTTL.
+type Option[K comparable, V any] func(*config[K, V])
+
+// OnEvict sets a function that is called with each entry that leaves the cache, other than by being replaced by Set.
+// It is called after the cache is unlocked, so it may use the cache.
+func OnEvict[K comparable, V any](action func(key K, value V, reason Reason)) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = action
+	}
+}
+
+// WithClock sets the Clock used by TTL to decide when entries expire. It is ignored by the other caches.
+func WithClock[K comparable, V any](clock Clock) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.clock = clock
+	}
+}
+
+func newConfig[K comparable, V any](opts []Option[K, V]) config[K, V] {
+	c := config[K, V]{clock: systemClock{}}
+	list.Iter(func(opt Option[K, V]) { opt(&c) }, opts)
+	return c
+}
+
+// eviction is an entry that left the cache, kept so the callback can be called after unlocking.
+type eviction[K comparable, V any] struct {
+	key    K
+	value  V
+	reason Reason
+}
+
+func (c config[K, V]) notify(evicted []eviction[K, V]) {
+	if c.onEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		c.onEvict(e.key, e.value, e.reason)
+	}
+}
+
+type call[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// flights makes sure that only one computation runs for each key at a time.
+type flights[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+func (f *flights[K, V]) do(key K, compute func() (V, error)) (V, error) {
+	f.mu.Lock()
+	if c, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+		<-c.done
+		return c.value, c.err
+	}
+	if f.calls == nil {
+		f.calls = make(map[K]*call[V])
+	}
+	c := &call[V]{done: make(chan struct{}), err: errors.Wrap(ComputePanickedErr, "GetOrCompute").With(errors.KeyKey, key)}
+	f.calls[key] = c
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		delete(f.calls, key)
+		f.mu.Unlock()
+		close(c.done)
+	}()
+	c.value, c.err = compute()
+	return c.value, c.err
+}
+
+// computer is implemented by the caches so getOrCompute can look a key up again without counting a miss twice.
+type computer[K comparable, V any] interface {
+	TryGet(key K) option.Option[V]
+	Set(key K, value V)
+	peek(key K) option.Option[V]
+}
+
+func getOrCompute[K comparable, V any](c computer[K, V], f *flights[K, V], key K, compute func() (V, error)) (V, error) {
+	if v := c.TryGet(key); v.IsSome() {
+		return v.Value(), nil
+	}
+	return f.do(key, func() (V, error) {
+		// Another caller may have finished computing the value just before this one started.
+		if v := c.peek(key); v.IsSome() {
+			return v.Value(), nil
+		}
+		v, err := compute()
+		if err == nil {
+			c.Set(key, v)
+		}
+		return v, err
+	})
+}
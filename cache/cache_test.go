@@ -0,0 +1,122 @@
+package cache_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/flowonyx/functional/cache"
+	"github.com/flowonyx/functional/errors"
+)
+
+func ExampleLRU_GetOrCompute() {
+	c := cache.NewLRU[string, int](10)
+	compute := func() (int, error) {
+		fmt.Println("computing")
+		return 42, nil
+	}
+	fmt.Println(c.GetOrCompute("answer", compute))
+	fmt.Println(c.GetOrCompute("answer", compute))
+
+	_, err := c.GetOrCompute("fails", func() (int, error) { return 0, errors.New("unavailable") })
+	fmt.Println(err, c.Contains("fails"))
+	// Output:
+	// computing
+	// 42 <nil>
+	// 42 <nil>
+	// unavailable false
+}
+
+func caches() map[string]cache.Cache[int, int] {
+	return map[string]cache.Cache[int, int]{
+		"LRU": cache.NewLRU[int, int](8),
+		"LFU": cache.NewLFU[int, int](8),
+		"TTL": cache.NewTTL[int, int](time.Hour, 8),
+	}
+}
+
+func TestGetOrComputeSingleFlight(t *testing.T) {
+	for name, c := range caches() {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			release := make(chan struct{})
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					v, err := c.GetOrCompute(1, func() (int, error) {
+						calls.Add(1)
+						<-release
+						return 7, nil
+					})
+					if v != 7 || err != nil {
+						t.Errorf("GetOrCompute = %d, %v, want 7, <nil>", v, err)
+					}
+				}()
+			}
+			time.Sleep(10 * time.Millisecond)
+			close(release)
+			wg.Wait()
+			if n := calls.Load(); n != 1 {
+				t.Errorf("compute was called %d times, want 1", n)
+			}
+		})
+	}
+}
+
+func TestGetOrComputePanic(t *testing.T) {
+	c := cache.NewLRU[int, int](1)
+	started := make(chan struct{})
+	waiting := make(chan error)
+	go func() {
+		defer func() { recover() }()
+		c.GetOrCompute(1, func() (int, error) {
+			close(started)
+			time.Sleep(10 * time.Millisecond)
+			panic("boom")
+		})
+	}()
+	<-started
+	go func() {
+		_, err := c.GetOrCompute(1, func() (int, error) { return 1, nil })
+		waiting <- err
+	}()
+	err := <-waiting
+	if err != nil && !errors.Is(err, cache.ComputePanickedErr) {
+		t.Errorf("got %v, want nil or ComputePanickedErr", err)
+	}
+}
+
+func TestCachesConcurrentUse(t *testing.T) {
+	for name, c := range caches() {
+		t.Run(name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			for g := 0; g < 8; g++ {
+				wg.Add(1)
+				go func(g int) {
+					defer wg.Done()
+					for i := 0; i < 200; i++ {
+						k := (g*7 + i) % 20
+						c.Set(k, i)
+						c.TryGet(k)
+						c.Contains(k + 1)
+						if i%17 == 0 {
+							c.Remove(k)
+						}
+						c.Keys()
+					}
+				}(g)
+			}
+			wg.Wait()
+			if c.Len() > 8 {
+				t.Errorf("Len() = %d, want at most 8", c.Len())
+			}
+			if s := c.Stats(); s.Hits+s.Misses != 8*200 {
+				t.Errorf("Hits+Misses = %d, want %d", s.Hits+s.Misses, 8*200)
+			}
+		})
+	}
+}
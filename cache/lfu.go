@@ -0,0 +1,234 @@
+package cache
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+
+	"github.com/flowonyx/functional/option"
+)
+
+type lfuEntry[K comparable, V any] struct {
+	key   K
+	value V
+	freq  int
+	el    *list.Element
+}
+
+// LFU is a cache that holds a fixed number of entries and evicts the least frequently used one to make room.
+// When several entries have been used equally often, the least recently used of them is evicted.
+type LFU[K comparable, V any] struct {
+	config   config[K, V]
+	flights  flights[K, V]
+	capacity int
+
+	mu      sync.Mutex
+	items   map[K]*lfuEntry[K, V]
+	freqs   map[int]*list.List
+	minFreq int
+	stats   Stats
+}
+
+var _ Cache[string, int] = (*LFU[string, int])(nil)
+
+// NewLFU creates an LFU that holds up to capacity entries. A capacity less than 1 is treated as 1.
+func NewLFU[K comparable, V any](capacity int, opts ...Option[K, V]) *LFU[K, V] {
+	return &LFU[K, V]{
+		config:   newConfig(opts),
+		capacity: max(capacity, 1),
+		items:    make(map[K]*lfuEntry[K, V]),
+		freqs:    make(map[int]*list.List),
+	}
+}
+
+func (c *LFU[K, V]) unlink(e *lfuEntry[K, V]) {
+	l := c.freqs[e.freq]
+	l.Remove(e.el)
+	if l.Len() == 0 {
+		delete(c.freqs, e.freq)
+		if c.minFreq == e.freq {
+			c.minFreq++
+		}
+	}
+}
+
+func (c *LFU[K, V]) link(e *lfuEntry[K, V]) {
+	l, ok := c.freqs[e.freq]
+	if !ok {
+		l = list.New()
+		c.freqs[e.freq] = l
+	}
+	e.el = l.PushFront(e)
+}
+
+func (c *LFU[K, V]) touch(e *lfuEntry[K, V]) {
+	c.unlink(e)
+	e.freq++
+	c.link(e)
+}
+
+func (c *LFU[K, V]) remove(e *lfuEntry[K, V]) {
+	c.unlink(e)
+	delete(c.items, e.key)
+}
+
+// leastFrequent returns the entry to evict. c.mu must be held and the cache must not be empty.
+func (c *LFU[K, V]) leastFrequent() *lfuEntry[K, V] {
+	if _, ok := c.freqs[c.minFreq]; !ok {
+		// Remove can leave minFreq pointing at a frequency nothing has any more.
+		c.minFreq = 0
+		for f := range c.freqs {
+			if c.minFreq == 0 || f < c.minFreq {
+				c.minFreq = f
+			}
+		}
+	}
+	return c.freqs[c.minFreq].Back().Value.(*lfuEntry[K, V])
+}
+
+// Get returns the value of the key or the zero value of the value type if it is not present.
+func (c *LFU[K, V]) Get(key K) V {
+	return option.DefaultValue(*new(V), c.TryGet(key))
+}
+
+// TryGet returns Some(value) if the key is present, otherwise it returns None.
+// Finding the key counts as a use of it.
+func (c *LFU[K, V]) TryGet(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return option.None[V]()
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return option.Some(e.value)
+}
+
+func (c *LFU[K, V]) peek(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return option.Some(e.value)
+	}
+	return option.None[V]()
+}
+
+// Frequency returns the number of times the key has been set or found, or 0 if it is not present.
+func (c *LFU[K, V]) Frequency(key K) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return e.freq
+	}
+	return 0
+}
+
+// Set adds or replaces the value of the key. Replacing a value counts as a use of the key.
+// If the cache is full, the least frequently used entry is evicted.
+func (c *LFU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.touch(e)
+		c.mu.Unlock()
+		return
+	}
+	var evicted []eviction[K, V]
+	if len(c.items) >= c.capacity {
+		victim := c.leastFrequent()
+		c.remove(victim)
+		c.stats.Evictions++
+		evicted = append(evicted, eviction[K, V]{victim.key, victim.value, Capacity})
+	}
+	e := &lfuEntry[K, V]{key: key, value: value, freq: 1}
+	c.items[key] = e
+	c.link(e)
+	c.minFreq = 1
+	c.mu.Unlock()
+	c.config.notify(evicted)
+}
+
+// Remove removes the key and reports whether it was present.
+func (c *LFU[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	e, ok := c.items[key]
+	if ok {
+		c.remove(e)
+	}
+	c.mu.Unlock()
+	if ok {
+		c.config.notify([]eviction[K, V]{{e.key, e.value, Removed}})
+	}
+	return ok
+}
+
+// Contains tests whether the key is present without counting as a use of it.
+func (c *LFU[K, V]) Contains(key K) bool {
+	return c.peek(key).IsSome()
+}
+
+// Len returns the number of entries.
+func (c *LFU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// entries returns the entries from the most to the least frequently used. c.mu must be held.
+func (c *LFU[K, V]) entries() []*lfuEntry[K, V] {
+	freqs := make([]int, 0, len(c.freqs))
+	for f := range c.freqs {
+		freqs = append(freqs, f)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(freqs)))
+	output := make([]*lfuEntry[K, V], 0, len(c.items))
+	for _, f := range freqs {
+		for el := c.freqs[f].Front(); el != nil; el = el.Next() {
+			output = append(output, el.Value.(*lfuEntry[K, V]))
+		}
+	}
+	return output
+}
+
+// Keys returns the keys from the most to the least frequently used.
+func (c *LFU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := c.entries()
+	keys := make([]K, len(entries))
+	for i, e := range entries {
+		keys[i] = e.key
+	}
+	return keys
+}
+
+// Clear removes every entry.
+func (c *LFU[K, V]) Clear() {
+	c.mu.Lock()
+	entries := c.entries()
+	c.items = make(map[K]*lfuEntry[K, V])
+	c.freqs = make(map[int]*list.List)
+	c.minFreq = 0
+	c.mu.Unlock()
+	evicted := make([]eviction[K, V], len(entries))
+	for i, e := range entries {
+		evicted[i] = eviction[K, V]{e.key, e.value, Removed}
+	}
+	c.config.notify(evicted)
+}
+
+// GetOrCompute returns the value of the key if it is present.
+// Otherwise it calls compute and, if compute succeeds, adds the value to the cache.
+// Callers that ask for the same key while compute is running wait for its result instead of calling compute again.
+func (c *LFU[K, V]) GetOrCompute(key K, compute func() (V, error)) (V, error) {
+	return getOrCompute[K, V](c, &c.flights, key, compute)
+}
+
+// Stats returns the hit, miss and eviction counts.
+func (c *LFU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
@@ -0,0 +1,33 @@
+package cache_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/cache"
+)
+
+func ExampleNewLFU() {
+	c := cache.NewLFU[string, int](2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	// "b" has been used less often than "a", so it is evicted even though it was used more recently.
+	c.Set("c", 3)
+	fmt.Println(c.Keys(), c.Frequency("a"), c.Frequency("c"))
+	// Output: [a c] 3 1
+}
+
+func ExampleLFU_Remove() {
+	c := cache.NewLFU[string, int](2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("b")
+	c.Get("b")
+	c.Remove("a")
+	c.Set("c", 3)
+	c.Set("d", 4)
+	fmt.Println(c.Keys())
+	// Output: [b d]
+}
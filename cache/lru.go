@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/flowonyx/functional/option"
+)
+
+// LRU is a cache that holds a fixed number of entries and evicts the least recently used one to make room.
+type LRU[K comparable, V any] struct {
+	config  config[K, V]
+	flights flights[K, V]
+
+	mu    sync.Mutex
+	items recency[K, V]
+	stats Stats
+}
+
+var _ Cache[string, int] = (*LRU[string, int])(nil)
+
+// NewLRU creates an LRU that holds up to capacity entries. A capacity less than 1 is treated as 1.
+func NewLRU[K comparable, V any](capacity int, opts ...Option[K, V]) *LRU[K, V] {
+	return &LRU[K, V]{config: newConfig(opts), items: newRecency[K, V](max(capacity, 1))}
+}
+
+// Get returns the value of the key or the zero value of the value type if it is not present.
+func (c *LRU[K, V]) Get(key K) V {
+	return option.DefaultValue(*new(V), c.TryGet(key))
+}
+
+// TryGet returns Some(value) if the key is present, otherwise it returns None.
+// Finding the key makes it the most recently used.
+func (c *LRU[K, V]) TryGet(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		return option.None[V]()
+	}
+	c.stats.Hits++
+	c.items.touch(key)
+	return option.Some(e.value)
+}
+
+func (c *LRU[K, V]) peek(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items.lookup(key); ok {
+		return option.Some(e.value)
+	}
+	return option.None[V]()
+}
+
+// Set adds or replaces the value of the key and makes it the most recently used.
+// If the cache is full, the least recently used entry is evicted.
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	evicted := c.items.set(key, value, time.Time{})
+	c.stats.Evictions += uint64(len(evicted))
+	c.mu.Unlock()
+	c.config.notify(evicted)
+}
+
+// Remove removes the key and reports whether it was present.
+func (c *LRU[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	e, ok := c.items.remove(key)
+	c.mu.Unlock()
+	if ok {
+		c.config.notify([]eviction[K, V]{{e.key, e.value, Removed}})
+	}
+	return ok
+}
+
+// Contains tests whether the key is present without making it the most recently used.
+func (c *LRU[K, V]) Contains(key K) bool {
+	return c.peek(key).IsSome()
+}
+
+// Len returns the number of entries.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.items.order.Len()
+}
+
+// Keys returns the keys from the most to the least recently used.
+func (c *LRU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := c.items.entries()
+	keys := make([]K, len(entries))
+	for i, e := range entries {
+		keys[i] = e.key
+	}
+	return keys
+}
+
+// Clear removes every entry.
+func (c *LRU[K, V]) Clear() {
+	c.mu.Lock()
+	evicted := c.items.clear(Removed)
+	c.mu.Unlock()
+	c.config.notify(evicted)
+}
+
+// GetOrCompute returns the value of the key if it is present.
+// Otherwise it calls compute and, if compute succeeds, adds the value to the cache.
+// Callers that ask for the same key while compute is running wait for its result instead of calling compute again.
+func (c *LRU[K, V]) GetOrCompute(key K, compute func() (V, error)) (V, error) {
+	return getOrCompute[K, V](c, &c.flights, key, compute)
+}
+
+// Stats returns the hit, miss and eviction counts.
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
@@ -0,0 +1,54 @@
+package cache_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/cache"
+)
+
+func ExampleNewLRU() {
+	c := cache.NewLRU(2, cache.OnEvict(func(key string, value int, reason cache.Reason) {
+		fmt.Println("evicted", key, value, reason)
+	}))
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+	fmt.Println(c.Keys())
+	fmt.Println(c.TryGet("b"), c.TryGet("a"))
+	fmt.Println(c.Stats(), c.Stats().HitRatio())
+	// Output:
+	// evicted b 2 capacity
+	// [c a]
+	// None Some(1)
+	// {2 1 1} 0.6666666666666666
+}
+
+func ExampleLRU_Contains() {
+	c := cache.NewLRU[string, int](2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	// Contains does not count as a use, so "a" is still evicted next.
+	fmt.Println(c.Contains("a"))
+	c.Set("c", 3)
+	fmt.Println(c.Keys())
+	// Output:
+	// true
+	// [c b]
+}
+
+func ExampleLRU_Remove() {
+	c := cache.NewLRU(3, cache.OnEvict(func(key string, _ int, reason cache.Reason) {
+		fmt.Println(key, reason)
+	}))
+	c.Set("a", 1)
+	c.Set("b", 2)
+	fmt.Println(c.Remove("a"), c.Remove("a"))
+	c.Clear()
+	fmt.Println(c.Len())
+	// Output:
+	// a removed
+	// true false
+	// b removed
+	// 0
+}
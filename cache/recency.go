@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// recency keeps entries in order of use, with the most recently used at the front.
+// It is the storage for both LRU and TTL, which must lock around it.
+type recency[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List
+}
+
+func newRecency[K comparable, V any](capacity int) recency[K, V] {
+	return recency[K, V]{capacity: capacity, items: make(map[K]*list.Element), order: list.New()}
+}
+
+func (r *recency[K, V]) lookup(key K) (*entry[K, V], bool) {
+	el, ok := r.items[key]
+	if !ok {
+		return nil, false
+	}
+	return el.Value.(*entry[K, V]), true
+}
+
+func (r *recency[K, V]) touch(key K) {
+	if el, ok := r.items[key]; ok {
+		r.order.MoveToFront(el)
+	}
+}
+
+// set adds or replaces an entry and returns the entries evicted to make room for it.
+func (r *recency[K, V]) set(key K, value V, expires time.Time) []eviction[K, V] {
+	if el, ok := r.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value, e.expires = value, expires
+		r.order.MoveToFront(el)
+		return nil
+	}
+	var evicted []eviction[K, V]
+	for r.capacity > 0 && r.order.Len() >= r.capacity {
+		e := r.order.Back().Value.(*entry[K, V])
+		r.remove(e.key)
+		evicted = append(evicted, eviction[K, V]{e.key, e.value, Capacity})
+	}
+	r.items[key] = r.order.PushFront(&entry[K, V]{key: key, value: value, expires: expires})
+	return evicted
+}
+
+func (r *recency[K, V]) remove(key K) (*entry[K, V], bool) {
+	el, ok := r.items[key]
+	if !ok {
+		return nil, false
+	}
+	delete(r.items, key)
+	return r.order.Remove(el).(*entry[K, V]), true
+}
+
+func (r *recency[K, V]) clear(reason Reason) []eviction[K, V] {
+	evicted := make([]eviction[K, V], 0, r.order.Len())
+	for el := r.order.Front(); el != nil; el = el.Next() {
+		e := el.Value.(*entry[K, V])
+		evicted = append(evicted, eviction[K, V]{e.key, e.value, reason})
+	}
+	r.items = make(map[K]*list.Element)
+	r.order.Init()
+	return evicted
+}
+
+// entries returns the entries from most to least recently used.
+func (r *recency[K, V]) entries() []*entry[K, V] {
+	output := make([]*entry[K, V], 0, r.order.Len())
+	for el := r.order.Front(); el != nil; el = el.Next() {
+		output = append(output, el.Value.(*entry[K, V]))
+	}
+	return output
+}
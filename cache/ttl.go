@@ -0,0 +1,196 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/flowonyx/functional/option"
+)
+
+// TTL is a cache whose entries expire after a time to live.
+// Expired entries are removed when they are next looked up or when Purge is called.
+// If it has a capacity, the least recently used entry is evicted to make room when it is full.
+type TTL[K comparable, V any] struct {
+	config  config[K, V]
+	flights flights[K, V]
+	ttl     time.Duration
+
+	mu    sync.Mutex
+	items recency[K, V]
+	stats Stats
+}
+
+var _ Cache[string, int] = (*TTL[string, int])(nil)
+
+// NewTTL creates a TTL cache where entries expire ttl after they are set.
+// A ttl of 0 or less means entries only expire if they are added with SetWithTTL.
+// A capacity of 0 or less means there is no limit to the number of entries.
+func NewTTL[K comparable, V any](ttl time.Duration, capacity int, opts ...Option[K, V]) *TTL[K, V] {
+	return &TTL[K, V]{config: newConfig(opts), ttl: ttl, items: newRecency[K, V](capacity)}
+}
+
+func (c *TTL[K, V]) expired(e *entry[K, V], now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// lookup returns the entry for key, removing it if it has expired. c.mu must be held.
+func (c *TTL[K, V]) lookup(key K) (*entry[K, V], []eviction[K, V]) {
+	e, ok := c.items.lookup(key)
+	if !ok {
+		return nil, nil
+	}
+	if c.expired(e, c.config.clock.Now()) {
+		c.items.remove(key)
+		c.stats.Evictions++
+		return nil, []eviction[K, V]{{e.key, e.value, Expired}}
+	}
+	return e, nil
+}
+
+// purge removes every expired entry. c.mu must be held.
+func (c *TTL[K, V]) purge() []eviction[K, V] {
+	now := c.config.clock.Now()
+	var evicted []eviction[K, V]
+	for _, e := range c.items.entries() {
+		if c.expired(e, now) {
+			c.items.remove(e.key)
+			evicted = append(evicted, eviction[K, V]{e.key, e.value, Expired})
+		}
+	}
+	c.stats.Evictions += uint64(len(evicted))
+	return evicted
+}
+
+// Get returns the value of the key or the zero value of the value type if it is not present or has expired.
+func (c *TTL[K, V]) Get(key K) V {
+	return option.DefaultValue(*new(V), c.TryGet(key))
+}
+
+// TryGet returns Some(value) if the key is present and has not expired, otherwise it returns None.
+func (c *TTL[K, V]) TryGet(key K) option.Option[V] {
+	c.mu.Lock()
+	e, evicted := c.lookup(key)
+	if e == nil {
+		c.stats.Misses++
+		c.mu.Unlock()
+		c.config.notify(evicted)
+		return option.None[V]()
+	}
+	c.stats.Hits++
+	c.items.touch(key)
+	v := e.value
+	c.mu.Unlock()
+	return option.Some(v)
+}
+
+func (c *TTL[K, V]) peek(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items.lookup(key); ok && !c.expired(e, c.config.clock.Now()) {
+		return option.Some(e.value)
+	}
+	return option.None[V]()
+}
+
+// Set adds or replaces the value of the key with the time to live given to NewTTL.
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL adds or replaces the value of the key with its own time to live.
+// A ttl of 0 or less means the entry does not expire.
+func (c *TTL[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var expires time.Time
+	c.mu.Lock()
+	if ttl > 0 {
+		expires = c.config.clock.Now().Add(ttl)
+	}
+	evicted := c.items.set(key, value, expires)
+	c.stats.Evictions += uint64(len(evicted))
+	c.mu.Unlock()
+	c.config.notify(evicted)
+}
+
+// ExpiresAt returns the time the key expires, or None if it is not present or does not expire.
+func (c *TTL[K, V]) ExpiresAt(key K) option.Option[time.Time] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items.lookup(key)
+	if !ok || e.expires.IsZero() || c.expired(e, c.config.clock.Now()) {
+		return option.None[time.Time]()
+	}
+	return option.Some(e.expires)
+}
+
+// Remove removes the key and reports whether it was present and had not expired.
+func (c *TTL[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	e, evicted := c.lookup(key)
+	if e != nil {
+		c.items.remove(key)
+		evicted = append(evicted, eviction[K, V]{e.key, e.value, Removed})
+	}
+	c.mu.Unlock()
+	c.config.notify(evicted)
+	return e != nil
+}
+
+// Contains tests whether the key is present and has not expired, without making it the most recently used.
+func (c *TTL[K, V]) Contains(key K) bool {
+	return c.peek(key).IsSome()
+}
+
+// Purge removes every expired entry and returns the number removed.
+func (c *TTL[K, V]) Purge() int {
+	c.mu.Lock()
+	evicted := c.purge()
+	c.mu.Unlock()
+	c.config.notify(evicted)
+	return len(evicted)
+}
+
+// Len returns the number of entries that have not expired.
+func (c *TTL[K, V]) Len() int {
+	c.mu.Lock()
+	evicted := c.purge()
+	n := c.items.order.Len()
+	c.mu.Unlock()
+	c.config.notify(evicted)
+	return n
+}
+
+// Keys returns the keys that have not expired from the most to the least recently used.
+func (c *TTL[K, V]) Keys() []K {
+	c.mu.Lock()
+	evicted := c.purge()
+	entries := c.items.entries()
+	c.mu.Unlock()
+	c.config.notify(evicted)
+	keys := make([]K, len(entries))
+	for i, e := range entries {
+		keys[i] = e.key
+	}
+	return keys
+}
+
+// Clear removes every entry.
+func (c *TTL[K, V]) Clear() {
+	c.mu.Lock()
+	evicted := c.items.clear(Removed)
+	c.mu.Unlock()
+	c.config.notify(evicted)
+}
+
+// GetOrCompute returns the value of the key if it is present and has not expired.
+// Otherwise it calls compute and, if compute succeeds, adds the value to the cache.
+// Callers that ask for the same key while compute is running wait for its result instead of calling compute again.
+func (c *TTL[K, V]) GetOrCompute(key K, compute func() (V, error)) (V, error) {
+	return getOrCompute[K, V](c, &c.flights, key, compute)
+}
+
+// Stats returns the hit, miss and eviction counts. Expired entries count as evictions.
+func (c *TTL[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
@@ -0,0 +1,33 @@
+package cache_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/flowonyx/functional/cache"
+	"github.com/flowonyx/functional/policy"
+)
+
+func ExampleNewTTL() {
+	clock := policy.NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	c := cache.NewTTL(time.Minute, 0,
+		cache.WithClock[string, string](clock),
+		cache.OnEvict(func(key, _ string, reason cache.Reason) { fmt.Println(key, reason) }),
+	)
+	c.Set("session", "alice")
+	c.SetWithTTL("token", "xyz", 10*time.Second)
+	c.SetWithTTL("config", "v1", 0)
+	fmt.Println(c.ExpiresAt("token").Value().Format(time.TimeOnly))
+
+	clock.Advance(30 * time.Second)
+	fmt.Println(c.Get("session"), c.TryGet("token"), c.Len())
+
+	clock.Advance(time.Minute)
+	fmt.Println(c.Purge(), c.Keys())
+	// Output:
+	// 00:00:10
+	// token expired
+	// alice None 2
+	// session expired
+	// 1 [config]
+}
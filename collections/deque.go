@@ -0,0 +1,170 @@
+// Package collections provides container types that the builtin slice does not do efficiently:
+// a double-ended queue, a priority queue and a fixed-size ring buffer.
+package collections
+
+import (
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// Pushing and popping at either end take constant time.
+// The zero value is an empty Deque that is ready to use.
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// NewDeque creates an empty Deque. If capacity is given, room for that many items is allocated up front.
+func NewDeque[T any](capacity ...int) *Deque[T] {
+	d := &Deque[T]{}
+	if len(capacity) > 0 && capacity[0] > 0 {
+		d.buf = make([]T, capacity[0])
+	}
+	return d
+}
+
+// DequeFrom creates a Deque holding values, with the first value at the front.
+func DequeFrom[T any](values []T) *Deque[T] {
+	d := NewDeque[T](len(values))
+	for _, v := range values {
+		d.PushBack(v)
+	}
+	return d
+}
+
+// index converts a position from the front into an index in buf.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+func (d *Deque[T]) grow() {
+	if d.count < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(4, 2*len(d.buf)))
+	for i := 0; i < d.count; i++ {
+		buf[i] = d.buf[d.index(i)]
+	}
+	d.buf, d.head = buf, 0
+}
+
+// Len returns the number of items.
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// IsEmpty tests whether the Deque has no items.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.count == 0
+}
+
+// PushBack adds value to the back.
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+	d.buf[d.index(d.count)] = value
+	d.count++
+}
+
+// PushFront adds value to the front.
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = value
+	d.count++
+}
+
+// PopFront removes and returns the item at the front.
+// It returns an IndexOutOfRangeErr if the Deque is empty.
+func (d *Deque[T]) PopFront() (T, error) {
+	if d.count == 0 {
+		return *(new(T)), errors.IndexOutOfRange("Deque.PopFront", 0, 0)
+	}
+	v := d.buf[d.head]
+	d.buf[d.head] = *(new(T))
+	d.head = d.index(1)
+	d.count--
+	return v, nil
+}
+
+// PopBack removes and returns the item at the back.
+// It returns an IndexOutOfRangeErr if the Deque is empty.
+func (d *Deque[T]) PopBack() (T, error) {
+	if d.count == 0 {
+		return *(new(T)), errors.IndexOutOfRange("Deque.PopBack", 0, 0)
+	}
+	i := d.index(d.count - 1)
+	v := d.buf[i]
+	d.buf[i] = *(new(T))
+	d.count--
+	return v, nil
+}
+
+// TryPopFront removes and returns the item at the front as Some, or returns None if the Deque is empty.
+func (d *Deque[T]) TryPopFront() option.Option[T] {
+	return tryOf(d.PopFront())
+}
+
+// TryPopBack removes and returns the item at the back as Some, or returns None if the Deque is empty.
+func (d *Deque[T]) TryPopBack() option.Option[T] {
+	return tryOf(d.PopBack())
+}
+
+// Item returns the item at index, counting from the front.
+// It returns an IndexOutOfRangeErr if index is outside the range of items.
+func (d *Deque[T]) Item(index int) (T, error) {
+	if index < 0 || index >= d.count {
+		return *(new(T)), errors.IndexOutOfRange("Deque.Item", index, d.count)
+	}
+	return d.buf[d.index(index)], nil
+}
+
+// TryPeekFront returns the item at the front without removing it, or None if the Deque is empty.
+func (d *Deque[T]) TryPeekFront() option.Option[T] {
+	return tryOf(d.Item(0))
+}
+
+// TryPeekBack returns the item at the back without removing it, or None if the Deque is empty.
+func (d *Deque[T]) TryPeekBack() option.Option[T] {
+	return tryOf(d.Item(d.count - 1))
+}
+
+// Clear removes every item, keeping the allocated space.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.count = 0, 0
+}
+
+// ToSlice returns the items from front to back as a new slice.
+func (d *Deque[T]) ToSlice() []T {
+	output := make([]T, d.count)
+	d.Iteri(func(i int, v T) { output[i] = v })
+	return output
+}
+
+// Iter applies action to each item from front to back.
+func (d *Deque[T]) Iter(action func(T)) {
+	d.Iteri(func(_ int, v T) { action(v) })
+}
+
+// Iteri applies action to each item from front to back with its index.
+func (d *Deque[T]) Iteri(action func(int, T)) {
+	for i := 0; i < d.count; i++ {
+		action(i, d.buf[d.index(i)])
+	}
+}
+
+// IterRev applies action to each item from back to front.
+func (d *Deque[T]) IterRev(action func(T)) {
+	for i := d.count - 1; i >= 0; i-- {
+		action(d.buf[d.index(i)])
+	}
+}
+
+func tryOf[T any](v T, err error) option.Option[T] {
+	if err != nil {
+		return option.None[T]()
+	}
+	return option.Some(v)
+}
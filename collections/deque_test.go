@@ -0,0 +1,67 @@
+package collections_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/collections"
+	"github.com/flowonyx/functional/list"
+)
+
+func ExampleDeque() {
+	d := collections.NewDeque[int]()
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	fmt.Println(d.ToSlice(), d.Len())
+	fmt.Println(d.PopFront())
+	fmt.Println(d.PopBack())
+	fmt.Println(d.TryPeekFront(), d.TryPeekBack())
+	// Output:
+	// [0 1 2 3] 4
+	// 0 <nil>
+	// 3 <nil>
+	// Some(1) Some(2)
+}
+
+func ExampleDeque_PopFront() {
+	var d collections.Deque[string]
+	_, err := d.PopFront()
+	fmt.Println(err)
+	fmt.Println(d.TryPopBack())
+	// Output:
+	// index out of range: Deque.PopFront(index=0, length=0)
+	// None
+}
+
+func ExampleDequeFrom() {
+	d := collections.DequeFrom([]string{"a", "b", "c"})
+	d.IterRev(func(s string) { fmt.Print(s) })
+	fmt.Println()
+	d.Iteri(func(i int, s string) { fmt.Print(i, s) })
+	fmt.Println()
+	fmt.Println(d.Item(1))
+	_, err := d.Item(3)
+	fmt.Println(err)
+	// Output:
+	// cba
+	// 0a1b2c
+	// b <nil>
+	// index out of range: Deque.Item(index=3, length=3)
+}
+
+func ExampleDeque_wrapAround() {
+	d := collections.NewDeque[int](4)
+	for i := 0; i < 10; i++ {
+		d.PushBack(i)
+		if i%3 == 0 {
+			d.PopFront()
+		}
+	}
+	d.PushFront(-1)
+	fmt.Println(d.ToSlice())
+	fmt.Println(list.Equal(d.ToSlice(), []int{-1, 4, 5, 6, 7, 8, 9}))
+	// Output:
+	// [-1 4 5 6 7 8 9]
+	// true
+}
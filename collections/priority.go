@@ -0,0 +1,195 @@
+package collections
+
+import (
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+)
+
+// Handle refers to an item in a PriorityQueue so its priority can be changed or it can be removed.
+type Handle[T any] struct {
+	value T
+	index int
+	queue *PriorityQueue[T]
+}
+
+// Value returns the item the handle refers to.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// InQueue tests whether the item is still in the queue it was pushed to.
+func (h *Handle[T]) InQueue() bool {
+	return h.index >= 0
+}
+
+// PriorityQueue is a queue that always pops the item that comes first according to a compare function,
+// in the same form as the one taken by list.SortWith.
+// Pushing and popping take logarithmic time. It is implemented as a binary heap.
+type PriorityQueue[T any] struct {
+	compare func(T, T) int
+	heap    []*Handle[T]
+}
+
+// NewPriorityQueue creates a PriorityQueue that pops the item for which compare returns a negative number
+// against every other item first, so cmp.Compare gives the smallest item first.
+// Any values given are pushed onto the queue.
+func NewPriorityQueue[T any](compare func(a, b T) int, values ...T) *PriorityQueue[T] {
+	q := &PriorityQueue[T]{compare: compare, heap: make([]*Handle[T], len(values))}
+	for i, v := range values {
+		q.heap[i] = &Handle[T]{value: v, index: i, queue: q}
+	}
+	for i := len(q.heap)/2 - 1; i >= 0; i-- {
+		q.down(i)
+	}
+	return q
+}
+
+func (q *PriorityQueue[T]) less(i, j int) bool {
+	return q.compare(q.heap[i].value, q.heap[j].value) < 0
+}
+
+func (q *PriorityQueue[T]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(i, parent) {
+			return
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) bool {
+	start := i
+	for {
+		first := 2*i + 1
+		if first >= len(q.heap) {
+			break
+		}
+		if second := first + 1; second < len(q.heap) && q.less(second, first) {
+			first = second
+		}
+		if !q.less(first, i) {
+			break
+		}
+		q.swap(i, first)
+		i = first
+	}
+	return i > start
+}
+
+// Len returns the number of items.
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.heap)
+}
+
+// IsEmpty tests whether the queue has no items.
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.heap) == 0
+}
+
+// Push adds value to the queue and returns a Handle that can be used to change its priority with Update
+// or to remove it with Remove.
+func (q *PriorityQueue[T]) Push(value T) *Handle[T] {
+	h := &Handle[T]{value: value, index: len(q.heap), queue: q}
+	q.heap = append(q.heap, h)
+	q.up(h.index)
+	return h
+}
+
+// Pop removes and returns the first item.
+// It returns an IndexOutOfRangeErr if the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, error) {
+	if len(q.heap) == 0 {
+		return *(new(T)), errors.IndexOutOfRange("PriorityQueue.Pop", 0, 0)
+	}
+	h := q.heap[0]
+	q.removeAt(0)
+	return h.value, nil
+}
+
+// TryPop removes and returns the first item as Some, or returns None if the queue is empty.
+func (q *PriorityQueue[T]) TryPop() option.Option[T] {
+	return tryOf(q.Pop())
+}
+
+// TryPeek returns the first item without removing it, or None if the queue is empty.
+func (q *PriorityQueue[T]) TryPeek() option.Option[T] {
+	if len(q.heap) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(q.heap[0].value)
+}
+
+func (q *PriorityQueue[T]) removeAt(i int) {
+	h := q.heap[i]
+	last := len(q.heap) - 1
+	if i != last {
+		q.swap(i, last)
+	}
+	q.heap[last] = nil
+	q.heap = q.heap[:last]
+	if i != last && !q.down(i) {
+		q.up(i)
+	}
+	h.index = -1
+}
+
+func (q *PriorityQueue[T]) check(op string, h *Handle[T]) error {
+	if h == nil || h.queue != q || h.index < 0 {
+		return errors.BadArgument(op, "h", "handle is not in this queue")
+	}
+	return nil
+}
+
+// Update changes the item referred to by h to value and moves it to its new place in the queue.
+// This is how to decrease (or increase) the priority of an item.
+// It returns a BadArgumentErr if h is not in this queue.
+func (q *PriorityQueue[T]) Update(h *Handle[T], value T) error {
+	if err := q.check("PriorityQueue.Update", h); err != nil {
+		return err
+	}
+	h.value = value
+	if !q.down(h.index) {
+		q.up(h.index)
+	}
+	return nil
+}
+
+// Remove removes the item referred to by h from the queue.
+// It returns a BadArgumentErr if h is not in this queue.
+func (q *PriorityQueue[T]) Remove(h *Handle[T]) error {
+	if err := q.check("PriorityQueue.Remove", h); err != nil {
+		return err
+	}
+	q.removeAt(h.index)
+	return nil
+}
+
+// Clear removes every item.
+func (q *PriorityQueue[T]) Clear() {
+	list.Iter(func(h *Handle[T]) { h.index = -1 }, q.heap)
+	q.heap = nil
+}
+
+// ToSlice returns the items in the order they would be popped, including among items with equal priority.
+func (q *PriorityQueue[T]) ToSlice() []T {
+	// the values are already in heap order, so the copy has the same heap and pops in the same order
+	clone := NewPriorityQueue(q.compare, list.Map(func(h *Handle[T]) T { return h.value }, q.heap)...)
+	return list.InitSlice(q.Len(), func(int) T {
+		v, _ := clone.Pop()
+		return v
+	})
+}
+
+// Iter applies action to each item in the order they would be popped.
+func (q *PriorityQueue[T]) Iter(action func(T)) {
+	list.Iter(action, q.ToSlice())
+}
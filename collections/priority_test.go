@@ -0,0 +1,99 @@
+package collections_test
+
+import (
+	"cmp"
+	"fmt"
+
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/collections"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+)
+
+func ExamplePriorityQueue() {
+	q := collections.NewPriorityQueue(cmp.Compare[int], 5, 1, 4)
+	q.Push(3)
+	q.Push(2)
+	fmt.Println(q.ToSlice(), q.TryPeek())
+	var popped []int
+	for !q.IsEmpty() {
+		v, _ := q.Pop()
+		popped = append(popped, v)
+	}
+	fmt.Println(popped)
+	fmt.Println(q.TryPop())
+	// Output:
+	// [1 2 3 4 5] Some(1)
+	// [1 2 3 4 5]
+	// None
+}
+
+type task struct {
+	name     string
+	priority int
+}
+
+func ExamplePriorityQueue_Update() {
+	byPriority := func(a, b task) int { return cmp.Compare(b.priority, a.priority) }
+	q := collections.NewPriorityQueue(byPriority)
+	q.Push(task{"write", 2})
+	read := q.Push(task{"read", 1})
+	cleanup := q.Push(task{"cleanup", 0})
+
+	// Raise the priority of "read" so it comes first.
+	q.Update(read, task{"read", 3})
+	q.Remove(cleanup)
+	fmt.Println(cleanup.InQueue())
+	q.Iter(func(t task) { fmt.Println(t.name) })
+	fmt.Println(q.Remove(cleanup))
+	// Output:
+	// false
+	// read
+	// write
+	// bad argument: PriorityQueue.Remove(arg=h): handle is not in this queue
+}
+
+func TestPriorityQueueSorts(t *testing.T) {
+	prop.ForAll(t, prop.SliceOf(prop.Int(-50, 50)), func(values []int) bool {
+		q := collections.NewPriorityQueue(cmp.Compare[int], values[:len(values)/2]...)
+		for _, v := range values[len(values)/2:] {
+			q.Push(v)
+		}
+		popped := make([]int, 0, len(values))
+		for !q.IsEmpty() {
+			v, _ := q.Pop()
+			popped = append(popped, v)
+		}
+		return list.Equal(popped, list.Sort(values))
+	})
+}
+
+func TestPriorityQueueUpdateKeepsOrder(t *testing.T) {
+	prop.ForAll(t, prop.SliceOf(prop.PairOf(prop.Int(0, 100), prop.Int(0, 100))), func(updates []functional.Pair[int, int]) bool {
+		q := collections.NewPriorityQueue(cmp.Compare[int])
+		handles := list.Map(func(u functional.Pair[int, int]) *collections.Handle[int] { return q.Push(u.First) }, updates)
+		list.Iter2(func(h *collections.Handle[int], u functional.Pair[int, int]) { q.Update(h, u.Second) }, handles, updates)
+		popped := make([]int, 0, len(updates))
+		for !q.IsEmpty() {
+			v, _ := q.Pop()
+			popped = append(popped, v)
+		}
+		return list.Equal(popped, list.Sort(list.Map(func(u functional.Pair[int, int]) int { return u.Second }, updates)))
+	})
+}
+
+func TestPriorityQueueToSliceMatchesPop(t *testing.T) {
+	byPriority := func(a, b functional.Pair[int, int]) int { return cmp.Compare(a.First, b.First) }
+	prop.ForAll(t, prop.SliceOf(prop.PairOf(prop.Int(0, 3), prop.Int(0, 100))), func(values []functional.Pair[int, int]) bool {
+		q := collections.NewPriorityQueue(byPriority, values...)
+		items := q.ToSlice()
+		popped := make([]functional.Pair[int, int], 0, len(values))
+		for !q.IsEmpty() {
+			v, _ := q.Pop()
+			popped = append(popped, v)
+		}
+		return list.Equal(items, popped)
+	}, prop.Seed(43))
+}
@@ -0,0 +1,128 @@
+package collections
+
+import (
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
+// BufferFullErr is returned by RingBuffer.Push when the buffer is full and its policy is Reject.
+const BufferFullErr = errors.FunctionalError("buffer full")
+
+// OverflowPolicy decides what a RingBuffer does when an item is pushed while it is full.
+type OverflowPolicy int
+
+const (
+	// Overwrite drops the oldest item to make room for the new one.
+	Overwrite OverflowPolicy = iota
+	// Reject keeps the items that are there and returns a BufferFullErr.
+	Reject
+)
+
+// RingBuffer holds up to a fixed number of items in the order they were pushed.
+// It never allocates after it is created.
+// The zero value is an empty RingBuffer that holds one item with the Overwrite policy, like NewRingBuffer(1).
+type RingBuffer[T any] struct {
+	items  Deque[T]
+	policy OverflowPolicy
+}
+
+// NewRingBuffer creates a RingBuffer that holds up to capacity items. A capacity less than 1 is treated as 1.
+// The policy for when it is full is Overwrite unless another is given.
+func NewRingBuffer[T any](capacity int, policy ...OverflowPolicy) *RingBuffer[T] {
+	r := &RingBuffer[T]{items: Deque[T]{buf: make([]T, max(capacity, 1))}}
+	if len(policy) > 0 {
+		r.policy = policy[0]
+	}
+	return r
+}
+
+// Len returns the number of items.
+func (r *RingBuffer[T]) Len() int {
+	return r.items.Len()
+}
+
+// Cap returns the number of items the buffer can hold.
+func (r *RingBuffer[T]) Cap() int {
+	return max(len(r.items.buf), 1)
+}
+
+// IsEmpty tests whether the buffer has no items.
+func (r *RingBuffer[T]) IsEmpty() bool {
+	return r.items.IsEmpty()
+}
+
+// IsFull tests whether the buffer holds as many items as it can.
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.Len() == r.Cap()
+}
+
+// Push adds value as the newest item.
+// If the buffer is full, the policy decides whether the oldest item is dropped
+// or value is rejected with a BufferFullErr.
+func (r *RingBuffer[T]) Push(value T) error {
+	if r.items.buf == nil {
+		// the zero value has no buffer yet, and the Deque would grow past the capacity of 1
+		r.items.buf = make([]T, 1)
+	}
+	if r.IsFull() {
+		if r.policy == Reject {
+			return errors.Wrap(BufferFullErr, "RingBuffer.Push").With(errors.LengthKey, r.Cap())
+		}
+		r.items.PopFront()
+	}
+	r.items.PushBack(value)
+	return nil
+}
+
+// Pop removes and returns the oldest item.
+// It returns an IndexOutOfRangeErr if the buffer is empty.
+func (r *RingBuffer[T]) Pop() (T, error) {
+	if r.IsEmpty() {
+		return *(new(T)), errors.IndexOutOfRange("RingBuffer.Pop", 0, 0)
+	}
+	return r.items.PopFront()
+}
+
+// TryPop removes and returns the oldest item as Some, or returns None if the buffer is empty.
+func (r *RingBuffer[T]) TryPop() option.Option[T] {
+	return tryOf(r.Pop())
+}
+
+// TryPeek returns the oldest item without removing it, or None if the buffer is empty.
+func (r *RingBuffer[T]) TryPeek() option.Option[T] {
+	return r.items.TryPeekFront()
+}
+
+// TryPeekNewest returns the newest item without removing it, or None if the buffer is empty.
+func (r *RingBuffer[T]) TryPeekNewest() option.Option[T] {
+	return r.items.TryPeekBack()
+}
+
+// Item returns the item at index, counting from the oldest.
+// It returns an IndexOutOfRangeErr if index is outside the range of items.
+func (r *RingBuffer[T]) Item(index int) (T, error) {
+	if index < 0 || index >= r.Len() {
+		return *(new(T)), errors.IndexOutOfRange("RingBuffer.Item", index, r.Len())
+	}
+	return r.items.Item(index)
+}
+
+// Clear removes every item.
+func (r *RingBuffer[T]) Clear() {
+	r.items.Clear()
+}
+
+// ToSlice returns the items from oldest to newest as a new slice.
+func (r *RingBuffer[T]) ToSlice() []T {
+	return r.items.ToSlice()
+}
+
+// Iter applies action to each item from oldest to newest.
+func (r *RingBuffer[T]) Iter(action func(T)) {
+	r.items.Iter(action)
+}
+
+// Iteri applies action to each item from oldest to newest with its index.
+func (r *RingBuffer[T]) Iteri(action func(int, T)) {
+	r.items.Iteri(action)
+}
@@ -0,0 +1,46 @@
+package collections_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/collections"
+)
+
+func ExampleRingBuffer() {
+	r := collections.NewRingBuffer[int](3)
+	for i := 1; i <= 5; i++ {
+		r.Push(i)
+	}
+	fmt.Println(r.ToSlice(), r.IsFull())
+	fmt.Println(r.TryPeek(), r.TryPeekNewest())
+	fmt.Println(r.Pop())
+	fmt.Println(r.ToSlice(), r.Len(), r.Cap())
+	// Output:
+	// [3 4 5] true
+	// Some(3) Some(5)
+	// 3 <nil>
+	// [4 5] 2 3
+}
+
+func ExampleRingBuffer_zero() {
+	var r collections.RingBuffer[int]
+	fmt.Println(r.Len(), r.Cap(), r.IsFull())
+	r.Push(1)
+	r.Push(2)
+	fmt.Println(r.ToSlice(), r.Cap(), r.IsFull())
+	// Output:
+	// 0 1 false
+	// [2] 1 true
+}
+
+func ExampleReject() {
+	r := collections.NewRingBuffer[string](2, collections.Reject)
+	r.Push("a")
+	r.Push("b")
+	fmt.Println(r.Push("c"))
+	r.Iteri(func(i int, s string) { fmt.Println(i, s) })
+	// Output:
+	// buffer full: RingBuffer.Push(length=2)
+	// 0 a
+	// 1 b
+}
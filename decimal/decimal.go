@@ -0,0 +1,315 @@
+// Package decimal provides an arbitrary-precision decimal number type for calculations,
+// such as money, where the rounding errors of float64 are not acceptable.
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+	"golang.org/x/exp/constraints"
+)
+
+// DivisionPlaces is the number of digits after the decimal point kept by Div and DivInt.
+// Use DivRound to choose a different number of places or rounding mode.
+const DivisionPlaces = 16
+
+// MaxExponent is the largest exponent, positive or negative, that a Decimal can have.
+// Parse returns a BadArgumentErr for a larger one, and New, NewFromBigInt, Mul, DivRound and RoundWith panic.
+// It keeps untrusted input such as "1e2000000000" from making String and arithmetic
+// build numbers with billions of digits.
+const MaxExponent = 100_000
+
+// checkExponent returns exp as an int32, or panics with a BadArgumentErr if it is outside -MaxExponent to MaxExponent.
+func checkExponent(op, arg string, exp int64) int32 {
+	if exp < -MaxExponent || exp > MaxExponent {
+		panic(errors.BadArgument(op, arg, "the exponent must be between -MaxExponent and MaxExponent").With("exponent", exp))
+	}
+	return int32(exp)
+}
+
+// Decimal is an immutable arbitrary-precision decimal number.
+// Its value is coefficient * 10^exponent.
+// The zero value is 0 and ready to use.
+type Decimal struct {
+	coef *big.Int
+	exp  int32
+}
+
+var (
+	// Zero is the Decimal 0.
+	Zero = Decimal{}
+	// One is the Decimal 1.
+	One = New(1, 0)
+)
+
+// New creates a Decimal with the value coefficient * 10^exponent.
+// New(12345, -2) is 123.45.
+// It panics if exponent is outside -MaxExponent to MaxExponent.
+func New(coefficient int64, exponent int32) Decimal {
+	return Decimal{coef: big.NewInt(coefficient), exp: checkExponent("decimal.New", "exponent", int64(exponent))}
+}
+
+// NewFromBigInt creates a Decimal with the value coefficient * 10^exponent.
+// The coefficient is copied so later changes to it do not affect the Decimal.
+// It panics if exponent is outside -MaxExponent to MaxExponent.
+func NewFromBigInt(coefficient *big.Int, exponent int32) Decimal {
+	return Decimal{coef: new(big.Int).Set(coefficient), exp: checkExponent("decimal.NewFromBigInt", "exponent", int64(exponent))}
+}
+
+// FromInt creates a Decimal from any integer type.
+func FromInt[T constraints.Integer](i T) Decimal {
+	if i > 0 {
+		return Decimal{coef: new(big.Int).SetUint64(uint64(i))}
+	}
+	return Decimal{coef: big.NewInt(int64(i))}
+}
+
+// FromFloat creates a Decimal with the shortest decimal representation of f that converts back to f.
+// It returns a BadArgumentErr if f is NaN or infinite.
+func FromFloat[T constraints.Float](f T) (Decimal, error) {
+	bitSize := 64
+	if unsafe.Sizeof(f) == 4 {
+		bitSize = 32
+	}
+	return Parse(strconv.FormatFloat(float64(f), 'g', -1, bitSize))
+}
+
+// Parse converts a string such as "-123.45" or "1.5e3" to a Decimal.
+// It returns a BadArgumentErr if s is not a valid number or its exponent,
+// counting the digits after the decimal point, is outside -MaxExponent to MaxExponent.
+func Parse[TString ~string](s TString) (Decimal, error) {
+	str := string(s)
+	badArg := fmt.Errorf("%w: decimal.Parse(%q)", errors.BadArgumentErr, str)
+	mantissa, exponent := str, int64(0)
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Zero, badArg
+		}
+		mantissa, exponent = str[:i], e
+	}
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") || strings.HasPrefix(mantissa, "+") {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	digits := whole + frac
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return Zero, badArg
+	}
+	exponent -= int64(len(frac))
+	if exponent < -MaxExponent || exponent > MaxExponent {
+		return Zero, fmt.Errorf("%w: exponent must be between %d and %d", badArg, -MaxExponent, MaxExponent)
+	}
+	coef, _ := new(big.Int).SetString(sign+digits, 10)
+	return Decimal{coef: coef, exp: int32(exponent)}, nil
+}
+
+// MustParse is the same as Parse but panics if s is not a valid number.
+func MustParse[TString ~string](s TString) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// ParseOpt is the same as Parse but returns None if s is not a valid number.
+func ParseOpt[TString ~string](s TString) option.Option[Decimal] {
+	d, err := Parse(s)
+	if err != nil {
+		return option.None[Decimal]()
+	}
+	return option.Some(d)
+}
+
+// coefficient returns the coefficient of d, treating the zero value as 0.
+// The result must not be modified.
+func (d Decimal) coefficient() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// Coefficient returns a copy of the coefficient of d.
+func (d Decimal) Coefficient() *big.Int {
+	return new(big.Int).Set(d.coefficient())
+}
+
+// Exponent returns the exponent of d.
+func (d Decimal) Exponent() int32 {
+	return d.exp
+}
+
+// Places returns the number of digits after the decimal point in d's representation.
+func (d Decimal) Places() int {
+	if d.exp >= 0 {
+		return 0
+	}
+	return int(-d.exp)
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+// rescale returns the coefficient of d expressed with the smaller exponent exp.
+func (d Decimal) rescale(exp int32) *big.Int {
+	if exp >= d.exp {
+		return d.coefficient()
+	}
+	return new(big.Int).Mul(d.coefficient(), pow10(int64(d.exp)-int64(exp)))
+}
+
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	exp := min(a.exp, b.exp)
+	return a.rescale(exp), b.rescale(exp), exp
+}
+
+// Add returns d + d2.
+func (d Decimal) Add(d2 Decimal) Decimal {
+	a, b, exp := align(d, d2)
+	return Decimal{coef: new(big.Int).Add(a, b), exp: exp}
+}
+
+// Sub returns d - d2.
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	a, b, exp := align(d, d2)
+	return Decimal{coef: new(big.Int).Sub(a, b), exp: exp}
+}
+
+// Mul returns d * d2.
+// It panics if the exponent of the result is outside -MaxExponent to MaxExponent.
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	exp := checkExponent("Decimal.Mul", "d2", int64(d.exp)+int64(d2.exp))
+	return Decimal{coef: new(big.Int).Mul(d.coefficient(), d2.coefficient()), exp: exp}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.coefficient()), exp: d.exp}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.coefficient()), exp: d.exp}
+}
+
+// Div returns d / d2 rounded half to even to DivisionPlaces digits after the decimal point.
+// It panics if d2 is zero.
+func (d Decimal) Div(d2 Decimal) Decimal {
+	return d.DivRound(d2, DivisionPlaces, HalfEven)
+}
+
+// DivInt returns d / n rounded half to even to DivisionPlaces digits after the decimal point.
+// It panics if n is zero.
+func (d Decimal) DivInt(n int) Decimal {
+	return d.Div(FromInt(n))
+}
+
+// DivRound returns d / d2 rounded to places digits after the decimal point using mode.
+// It panics if d2 is zero or places is outside -MaxExponent to MaxExponent.
+func (d Decimal) DivRound(d2 Decimal, places int, mode RoundingMode) Decimal {
+	exp := checkExponent("Decimal.DivRound", "places", -int64(places))
+	if d2.IsZero() {
+		panic("decimal division by zero")
+	}
+	// d / d2 = (c1 * 10^e1) / (c2 * 10^e2), so the result scaled by 10^places
+	// is c1 * 10^(e1 - e2 + places) / c2
+	num, den := d.Coefficient(), d2.Coefficient()
+	shift := int64(d.exp) - int64(d2.exp) + int64(places)
+	if shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	return Decimal{coef: roundQuotient(num, den, mode), exp: exp}
+}
+
+// Cmp compares d and d2 and returns -1 if d < d2, 0 if d == d2 and +1 if d > d2.
+func (d Decimal) Cmp(d2 Decimal) int {
+	a, b, _ := align(d, d2)
+	return a.Cmp(b)
+}
+
+// Compare returns -1 if a < b, 0 if a == b and +1 if a > b.
+// It can be passed directly to list.SortWith.
+func Compare(a, b Decimal) int {
+	return a.Cmp(b)
+}
+
+// Equal tests whether d and d2 have the same value. 1.5 and 1.50 are equal.
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// LessThan tests whether d < d2.
+func (d Decimal) LessThan(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+// GreaterThan tests whether d > d2.
+func (d Decimal) GreaterThan(d2 Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+// Sign returns -1 if d is negative, 0 if d is zero and +1 if d is positive.
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+// IsZero tests whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// IntPart returns the integer part of d, truncated toward zero, as a big.Int.
+func (d Decimal) IntPart() *big.Int {
+	return d.RoundWith(0, Down).rescale(0)
+}
+
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns d in plain notation, keeping the number of places it was created with.
+// New(150, -2).String() is "1.50".
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.coefficient()).String()
+	sign := ""
+	if d.Sign() < 0 {
+		sign = "-"
+	}
+	if d.exp >= 0 {
+		if digits == "0" {
+			return "0"
+		}
+		return sign + digits + strings.Repeat("0", int(d.exp))
+	}
+	places := int(-d.exp)
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+	point := len(digits) - places
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// StringFixed returns d rounded half away from zero to places digits after the decimal point,
+// padding with zeros if needed. New(15, -1).StringFixed(2) is "1.50".
+// It panics if places is outside -MaxExponent to MaxExponent.
+func (d Decimal) StringFixed(places int) string {
+	r := d.Round(places)
+	if r.Places() < places {
+		exp := int32(-places)
+		r = Decimal{coef: r.rescale(exp), exp: exp}
+	}
+	return r.String()
+}
@@ -0,0 +1,128 @@
+package decimal_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/flowonyx/functional/decimal"
+	"github.com/flowonyx/functional/errors"
+)
+
+func ExampleNew() {
+	fmt.Println(decimal.New(12345, -2))
+	fmt.Println(decimal.New(12, 3))
+	// Output:
+	// 123.45
+	// 12000
+}
+
+func ExampleParse() {
+	d, err := decimal.Parse("-0.0150")
+	fmt.Println(d, d.Places(), err)
+	d, err = decimal.Parse("1.5e3")
+	fmt.Println(d, err)
+	_, err = decimal.Parse("1.2.3")
+	fmt.Println(err)
+	_, err = decimal.Parse("1e2000000000")
+	fmt.Println(err)
+	// Output:
+	// -0.0150 4 <nil>
+	// 1500 <nil>
+	// bad argument: decimal.Parse("1.2.3")
+	// bad argument: decimal.Parse("1e2000000000"): exponent must be between -100000 and 100000
+}
+
+func ExampleParseOpt() {
+	fmt.Println(decimal.ParseOpt("19.99"))
+	fmt.Println(decimal.ParseOpt("nineteen"))
+	// Output:
+	// Some(19.99)
+	// None
+}
+
+func ExampleFromFloat() {
+	a, b := 0.1, 0.2
+	da, _ := decimal.FromFloat(a)
+	db, _ := decimal.FromFloat(b)
+	fmt.Println(da.Add(db))
+	fmt.Println(a + b)
+	// Output:
+	// 0.3
+	// 0.30000000000000004
+}
+
+type celsius float32
+
+func TestFromFloatNamedFloat32(t *testing.T) {
+	d, err := decimal.FromFloat(celsius(0.1))
+	if err != nil || d.String() != "0.1" {
+		t.Errorf("FromFloat(celsius(0.1)) = %v, %v, want 0.1", d, err)
+	}
+}
+
+func TestExponentBounds(t *testing.T) {
+	huge, tiny := decimal.New(1, decimal.MaxExponent), decimal.New(1, -decimal.MaxExponent)
+	for name, f := range map[string]func(){
+		"New":           func() { decimal.New(1, 2_000_000_000) },
+		"NewFromBigInt": func() { decimal.NewFromBigInt(big.NewInt(1), -decimal.MaxExponent-1) },
+		"Mul":           func() { huge.Mul(huge) },
+		"DivRound":      func() { huge.DivRound(tiny, math.MaxInt, decimal.HalfEven) },
+		"RoundWith":     func() { tiny.RoundWith(math.MinInt, decimal.Down) },
+		"StringFixed":   func() { _ = huge.StringFixed(1 << 40) },
+	} {
+		func() {
+			defer func() {
+				if err, ok := recover().(error); !ok || !errors.Is(err, errors.BadArgumentErr) {
+					t.Errorf("%s did not panic with a BadArgumentErr when the exponent was out of range", name)
+				}
+			}()
+			f()
+		}()
+	}
+	// the largest gap between exponents is still quick to align
+	if got := huge.Add(tiny).Sub(huge); !got.Equal(tiny) {
+		t.Errorf("got %v, want %v", got, tiny)
+	}
+}
+
+func ExampleDecimal_Add() {
+	total := decimal.Zero
+	for _, price := range []string{"19.99", "5.01", "0.10"} {
+		total = total.Add(decimal.MustParse(price))
+	}
+	fmt.Println(total)
+	// Output: 25.10
+}
+
+func ExampleDecimal_Mul() {
+	price := decimal.MustParse("19.99")
+	fmt.Println(price.Mul(decimal.FromInt(3)))
+	fmt.Println(price.Mul(decimal.MustParse("0.2")).Round(2))
+	// Output:
+	// 59.97
+	// 4.00
+}
+
+func ExampleDecimal_Div() {
+	fmt.Println(decimal.One.Div(decimal.FromInt(3)))
+	fmt.Println(decimal.FromInt(10).DivRound(decimal.FromInt(3), 2, decimal.Ceiling))
+	// Output:
+	// 0.3333333333333333
+	// 3.34
+}
+
+func ExampleDecimal_Cmp() {
+	a, b := decimal.MustParse("1.5"), decimal.MustParse("1.50")
+	fmt.Println(a.Cmp(b), a.Equal(b), a.LessThan(decimal.FromInt(2)))
+	// Output: 0 true true
+}
+
+func ExampleDecimal_StringFixed() {
+	fmt.Println(decimal.MustParse("3").StringFixed(2))
+	fmt.Println(decimal.MustParse("2.675").StringFixed(2))
+	// Output:
+	// 3.00
+	// 2.68
+}
@@ -0,0 +1,73 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/flowonyx/functional/errors"
+)
+
+// MarshalText implements encoding.TextMarshaler using the String form of d.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using Parse.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// d is written as a quoted string so that JSON decoders using float64 do not lose precision.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts both JSON numbers and quoted strings. null leaves d unchanged.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// Value implements driver.Valuer so d can be written to a database as a string,
+// which NUMERIC and DECIMAL columns accept without loss of precision.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner so d can be read from a database.
+// It accepts strings, byte slices, integers and floats.
+// A BadArgumentErr is returned for other types, including NULL.
+// Use sql.Null[Decimal] for nullable columns.
+func (d *Decimal) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	case int64:
+		*d = FromInt(v)
+		return nil
+	case float64:
+		f, err := FromFloat(v)
+		if err != nil {
+			return err
+		}
+		*d = f
+		return nil
+	}
+	return fmt.Errorf("%w: cannot scan %T into a Decimal", errors.BadArgumentErr, src)
+}
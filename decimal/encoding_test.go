@@ -0,0 +1,40 @@
+package decimal_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/flowonyx/functional/decimal"
+	"github.com/flowonyx/functional/errors"
+)
+
+func ExampleDecimal_MarshalJSON() {
+	type invoice struct {
+		Total decimal.Decimal `json:"total"`
+	}
+	b, _ := json.Marshal(invoice{Total: decimal.MustParse("1024.50")})
+	fmt.Println(string(b))
+
+	var i invoice
+	_ = json.Unmarshal([]byte(`{"total": 0.10}`), &i)
+	fmt.Println(i.Total)
+	err := json.Unmarshal([]byte(`{"total": 1e2000000000}`), &i)
+	fmt.Println(errors.Is(err, errors.BadArgumentErr))
+	// Output:
+	// {"total":"1024.50"}
+	// 0.10
+	// true
+}
+
+func ExampleDecimal_Scan() {
+	var d decimal.Decimal
+	_ = d.Scan([]byte("12.3400"))
+	fmt.Println(d)
+	v, _ := d.Value()
+	fmt.Printf("%q\n", v)
+	fmt.Println(d.Scan(nil))
+	// Output:
+	// 12.3400
+	// "12.3400"
+	// bad argument: cannot scan <nil> into a Decimal
+}
@@ -0,0 +1,98 @@
+package decimal
+
+import "math/big"
+
+// RoundingMode decides how a value is rounded when digits are dropped.
+type RoundingMode int
+
+const (
+	// HalfEven rounds to the nearest value and ties to the even neighbour (banker's rounding).
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest value and ties away from zero, like math.Round.
+	HalfUp
+	// HalfDown rounds to the nearest value and ties toward zero.
+	HalfDown
+	// Down rounds toward zero (truncation).
+	Down
+	// Up rounds away from zero.
+	Up
+	// Ceiling rounds toward positive infinity.
+	Ceiling
+	// Floor rounds toward negative infinity.
+	Floor
+)
+
+// roundQuotient returns num / den rounded to an integer using mode.
+func roundQuotient(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// the sign of the exact result, which QuoRem truncated toward zero
+	negative := (num.Sign() < 0) != (den.Sign() < 0)
+	awayFromZero := false
+	switch mode {
+	case Up:
+		awayFromZero = true
+	case Ceiling:
+		awayFromZero = !negative
+	case Floor:
+		awayFromZero = negative
+	case HalfEven, HalfUp, HalfDown:
+		half := new(big.Int).Abs(r)
+		half.Lsh(half, 1)
+		switch half.Cmp(new(big.Int).Abs(den)) {
+		case 1:
+			awayFromZero = true
+		case 0:
+			awayFromZero = mode == HalfUp || (mode == HalfEven && q.Bit(0) == 1)
+		}
+	}
+	if awayFromZero {
+		if negative {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// RoundWith returns d rounded to places digits after the decimal point using mode.
+// places may be negative to round to tens, hundreds and so on.
+// If d already has no more than places digits after the decimal point, it is returned unchanged.
+// It panics if places is outside -MaxExponent to MaxExponent.
+func (d Decimal) RoundWith(places int, mode RoundingMode) Decimal {
+	exp := checkExponent("Decimal.RoundWith", "places", -int64(places))
+	if d.exp >= exp {
+		return d
+	}
+	den := pow10(int64(exp) - int64(d.exp))
+	return Decimal{coef: roundQuotient(d.coefficient(), den, mode), exp: exp}
+}
+
+// Round returns d rounded half away from zero to places digits after the decimal point, like math.Round.
+// Use RoundWith to choose a different rounding mode.
+func (d Decimal) Round(places int) Decimal {
+	return d.RoundWith(places, HalfUp)
+}
+
+// RoundBank returns d rounded half to even to places digits after the decimal point.
+func (d Decimal) RoundBank(places int) Decimal {
+	return d.RoundWith(places, HalfEven)
+}
+
+// Truncate returns d with any digits after places digits after the decimal point dropped.
+func (d Decimal) Truncate(places int) Decimal {
+	return d.RoundWith(places, Down)
+}
+
+// Ceil returns the smallest integer value greater than or equal to d.
+func (d Decimal) Ceil() Decimal {
+	return d.RoundWith(0, Ceiling)
+}
+
+// Floor returns the largest integer value less than or equal to d.
+func (d Decimal) Floor() Decimal {
+	return d.RoundWith(0, Floor)
+}
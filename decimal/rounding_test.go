@@ -0,0 +1,48 @@
+package decimal_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional/decimal"
+)
+
+func ExampleDecimal_RoundWith() {
+	d := decimal.MustParse("2.345")
+	fmt.Println(d.RoundWith(2, decimal.HalfEven), d.RoundWith(2, decimal.HalfUp), d.RoundWith(2, decimal.Down), d.RoundWith(2, decimal.Ceiling))
+	fmt.Println(decimal.MustParse("1250").RoundWith(-2, decimal.HalfEven))
+	// Output:
+	// 2.34 2.35 2.34 2.35
+	// 1200
+}
+
+func TestRoundWith(t *testing.T) {
+	tests := []struct {
+		value string
+		mode  decimal.RoundingMode
+		want  string
+	}{
+		{"2.5", decimal.HalfEven, "2"},
+		{"3.5", decimal.HalfEven, "4"},
+		{"-2.5", decimal.HalfEven, "-2"},
+		{"2.5", decimal.HalfUp, "3"},
+		{"-2.5", decimal.HalfUp, "-3"},
+		{"2.5", decimal.HalfDown, "2"},
+		{"2.51", decimal.HalfDown, "3"},
+		{"2.9", decimal.Down, "2"},
+		{"-2.9", decimal.Down, "-2"},
+		{"2.1", decimal.Up, "3"},
+		{"-2.1", decimal.Up, "-3"},
+		{"2.1", decimal.Ceiling, "3"},
+		{"-2.9", decimal.Ceiling, "-2"},
+		{"2.9", decimal.Floor, "2"},
+		{"-2.1", decimal.Floor, "-3"},
+		{"7", decimal.Up, "7"},
+	}
+	for _, tt := range tests {
+		got := decimal.MustParse(tt.value).RoundWith(0, tt.mode).String()
+		if got != tt.want {
+			t.Errorf("RoundWith(%s, 0, %d) = %s, want %s", tt.value, tt.mode, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Package eq provides ways to compare and hash values of types that cannot use ==,
+// such as slices, maps and strings that should match without regard to case.
+// The ...With functions in the list, set and orderedMap packages accept them in place of ==.
+package eq
+
+import (
+	"hash/maphash"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Equaler tests whether two values are equal.
+// Equal must be reflexive, symmetric and transitive.
+type Equaler[T any] interface {
+	Equal(a, b T) bool
+}
+
+// Hasher is an Equaler that can also hash values so they can be found without comparing against every other value.
+// Values that are equal must have the same hash.
+// Hashes are only stable within one run of a program, so they should not be stored or sent elsewhere.
+type Hasher[T any] interface {
+	Equaler[T]
+	Hash(value T) uint64
+}
+
+// EqualFunc is a function that is used as an Equaler.
+type EqualFunc[T any] func(a, b T) bool
+
+// Equal calls the function.
+func (f EqualFunc[T]) Equal(a, b T) bool {
+	return f(a, b)
+}
+
+type hasher[T any] struct {
+	equal func(a, b T) bool
+	hash  func(T) uint64
+}
+
+func (h hasher[T]) Equal(a, b T) bool {
+	return h.equal(a, b)
+}
+
+func (h hasher[T]) Hash(value T) uint64 {
+	return h.hash(value)
+}
+
+// New creates a Hasher from an equality function and a hash function.
+// hash must return the same value for any two values that equal reports as equal.
+func New[T any](equal func(a, b T) bool, hash func(T) uint64) Hasher[T] {
+	return hasher[T]{equal: equal, hash: hash}
+}
+
+// Comparable returns an Equaler that uses ==.
+func Comparable[T comparable]() Equaler[T] {
+	return EqualFunc[T](func(a, b T) bool { return a == b })
+}
+
+// By returns a Hasher that compares and hashes values by the key returned from projection.
+func By[T, Key any](projection func(T) Key, key Hasher[Key]) Hasher[T] {
+	return New(func(a, b T) bool {
+		return key.Equal(projection(a), projection(b))
+	}, func(value T) uint64 {
+		return key.Hash(projection(value))
+	})
+}
+
+var seed = maphash.MakeSeed()
+
+// mix spreads the bits of h so that values that differ in a few bits hash far apart.
+func mix(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+// combine adds the hash h to the running hash acc, so that the order of hashes matters.
+func combine(acc, h uint64) uint64 {
+	return mix(acc ^ (h + 0x9e3779b97f4a7c15 + acc<<6 + acc>>2))
+}
+
+// String returns a Hasher for strings that uses ==.
+func String[T ~string]() Hasher[T] {
+	return New(func(a, b T) bool { return a == b }, func(value T) uint64 {
+		return maphash.String(seed, string(value))
+	})
+}
+
+// Integer returns a Hasher for integers that uses ==.
+func Integer[T constraints.Integer]() Hasher[T] {
+	return New(func(a, b T) bool { return a == b }, func(value T) uint64 {
+		return mix(uint64(value))
+	})
+}
+
+// Float returns a Hasher for floating point numbers that uses ==.
+// As with ==, 0 and -0 are equal and NaN is not equal to anything, even itself.
+func Float[T constraints.Float]() Hasher[T] {
+	return New(func(a, b T) bool { return a == b }, func(value T) uint64 {
+		if value == 0 {
+			return mix(0)
+		}
+		return mix(math.Float64bits(float64(value)))
+	})
+}
+
+// Bool returns a Hasher for bools that uses ==.
+func Bool() Hasher[bool] {
+	return New(func(a, b bool) bool { return a == b }, func(value bool) uint64 {
+		if value {
+			return mix(1)
+		}
+		return mix(0)
+	})
+}
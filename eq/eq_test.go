@@ -0,0 +1,100 @@
+package eq_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/eq"
+	"github.com/flowonyx/functional/option"
+	"github.com/flowonyx/functional/prop"
+)
+
+type user struct {
+	Name  string
+	Roles []string
+}
+
+func ExampleSlice() {
+	h := eq.Slice(eq.Integer[int]())
+	fmt.Println(h.Equal([]int{1, 2}, []int{1, 2}), h.Equal([]int{1, 2}, []int{2, 1}), h.Equal(nil, []int{}))
+	fmt.Println(h.Hash([]int{1, 2}) == h.Hash([]int{1, 2}))
+	// Output:
+	// true false true
+	// true
+}
+
+func ExampleMap() {
+	h := eq.Map(eq.String[string](), eq.Slice(eq.Integer[int]()))
+	a := map[string][]int{"a": {1}, "b": {2, 3}}
+	b := map[string][]int{"b": {2, 3}, "a": {1}}
+	fmt.Println(h.Equal(a, b), h.Hash(a) == h.Hash(b), h.Equal(a, map[string][]int{"a": {1}}))
+	// Output: true true false
+}
+
+func ExamplePair() {
+	h := eq.Pair(eq.Folded[string](), eq.Integer[int]())
+	fmt.Println(h.Equal(functional.PairOf("Go", 1), functional.PairOf("GO", 1)), h.Equal(functional.PairOf("Go", 1), functional.PairOf("Go", 2)))
+	// Output: true false
+}
+
+func ExampleOption() {
+	h := eq.Option(eq.Float[float64]())
+	fmt.Println(h.Equal(option.Some(0.0), option.Some(-0.0)), h.Equal(option.None[float64](), option.None[float64]()), h.Equal(option.Some(1.0), option.None[float64]()))
+	// Output: true true false
+}
+
+func ExampleFolded() {
+	h := eq.Folded[string]()
+	fmt.Println(h.Equal("Straße", "STRAßE"), h.Equal("Σ", "ς"), h.Equal("go", "gopher"))
+	fmt.Println(h.Hash("Hello") == h.Hash("hELLO"))
+	// Output:
+	// true true false
+	// true
+}
+
+func ExampleBy() {
+	byName := eq.By(func(u user) string { return u.Name }, eq.Folded[string]())
+	fmt.Println(byName.Equal(user{Name: "Ann", Roles: []string{"admin"}}, user{Name: "ann"}))
+	// Output: true
+}
+
+func ExampleComparable() {
+	var e eq.Equaler[int] = eq.Comparable[int]()
+	fmt.Println(e.Equal(1, 1), e.Equal(1, 2))
+	// Output: true false
+}
+
+// TestFoldedHash checks that strings that differ only in case have the same hash.
+func TestFoldedHash(t *testing.T) {
+	h := eq.Folded[string]()
+	words := prop.StringOf(prop.RuneOf("aAbBσΣςkKKKßẞ"))
+	prop.ForAll(t, prop.PairOf(words, prop.SliceOf(prop.Bool())), func(p functional.Pair[string, []bool]) bool {
+		changed := []rune(p.First)
+		for i := range changed {
+			if i < len(p.Second) && p.Second[i] {
+				changed[i] = []rune(strings.ToUpper(string(changed[i])))[0]
+			}
+		}
+		return !h.Equal(p.First, string(changed)) || h.Hash(p.First) == h.Hash(string(changed))
+	})
+}
+
+// TestEqualHash checks that equal values have the same hash.
+func TestEqualHash(t *testing.T) {
+	h := eq.Slice(eq.Pair(eq.Folded[string](), eq.Option(eq.Integer[int]())))
+	items := prop.PairOf(prop.StringOf(prop.RuneOf("aAbB")), prop.Map(func(i int) option.Option[int] {
+		if i < 0 {
+			return option.None[int]()
+		}
+		return option.Some(i)
+	}, prop.Int(-1, 2)))
+	prop.ForAll(t, prop.SliceOf(items), func(values []functional.Pair[string, option.Option[int]]) bool {
+		upper := make([]functional.Pair[string, option.Option[int]], len(values))
+		for i, v := range values {
+			upper[i] = functional.PairOf(strings.ToUpper(v.First), v.Second)
+		}
+		return h.Equal(values, upper) && h.Hash(values) == h.Hash(upper)
+	})
+}
@@ -0,0 +1,103 @@
+package eq
+
+import (
+	"hash/maphash"
+	"strings"
+	"unicode"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/option"
+)
+
+// Slice returns a Hasher for slices that are equal when they have the same length
+// and the items at each index are equal by item. A nil slice is equal to an empty slice.
+func Slice[T any](item Hasher[T]) Hasher[[]T] {
+	return New(func(a, b []T) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !item.Equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}, func(value []T) uint64 {
+		h := mix(uint64(len(value)))
+		for _, v := range value {
+			h = combine(h, item.Hash(v))
+		}
+		return h
+	})
+}
+
+// Map returns a Hasher for maps that are equal when they have the same keys
+// and the values for each key are equal by value. A nil map is equal to an empty map.
+// Keys are matched with ==; key is only used for hashing and must agree with ==.
+func Map[K comparable, V any](key Hasher[K], value Hasher[V]) Hasher[map[K]V] {
+	return New(func(a, b map[K]V) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for k, va := range a {
+			vb, ok := b[k]
+			if !ok || !value.Equal(va, vb) {
+				return false
+			}
+		}
+		return true
+	}, func(m map[K]V) uint64 {
+		// The hashes of the entries are added so the order the map is ranged over does not matter.
+		h := mix(uint64(len(m)))
+		for k, v := range m {
+			h += combine(key.Hash(k), value.Hash(v))
+		}
+		return h
+	})
+}
+
+// Pair returns a Hasher for Pairs that are equal when both their first and second values are equal.
+func Pair[T1, T2 any](first Hasher[T1], second Hasher[T2]) Hasher[functional.Pair[T1, T2]] {
+	return New(func(a, b functional.Pair[T1, T2]) bool {
+		return first.Equal(a.First, b.First) && second.Equal(a.Second, b.Second)
+	}, func(value functional.Pair[T1, T2]) uint64 {
+		return combine(first.Hash(value.First), second.Hash(value.Second))
+	})
+}
+
+// Option returns a Hasher for Options that are equal when both are None
+// or both are Some with values that are equal by value.
+func Option[T any](value Hasher[T]) Hasher[option.Option[T]] {
+	return New(func(a, b option.Option[T]) bool {
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+		return value.Equal(a.Value(), b.Value())
+	}, func(o option.Option[T]) uint64 {
+		if o.IsNone() {
+			return mix(0)
+		}
+		return combine(mix(1), value.Hash(o.Value()))
+	})
+}
+
+// Folded returns a Hasher for strings that are equal under simple Unicode case folding, as with strings.EqualFold.
+// "Go", "GO" and "go" are all equal.
+func Folded[T ~string]() Hasher[T] {
+	return New(func(a, b T) bool {
+		return strings.EqualFold(string(a), string(b))
+	}, func(value T) uint64 {
+		return maphash.String(seed, strings.Map(foldRune, string(value)))
+	})
+}
+
+// foldRune returns the smallest rune that r folds to, so that all runes EqualFold treats as equal give the same rune.
+func foldRune(r rune) rune {
+	lowest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < lowest {
+			lowest = f
+		}
+	}
+	return lowest
+}
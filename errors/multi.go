@@ -0,0 +1,81 @@
+package errors
+
+import (
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// MultiError holds several errors that happened together, such as the failures
+// of every item that was validated.
+// Is and As match any of the errors it holds.
+type MultiError struct {
+	errs []error
+}
+
+// Join returns a MultiError holding the non-nil errors in errs.
+// It returns nil if every error is nil and flattens any MultiError in errs
+// so the errors are never nested.
+func Join(errs ...error) error {
+	var all []error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if m, ok := err.(*MultiError); ok {
+			all = append(all, m.errs...)
+			continue
+		}
+		all = append(all, err)
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	return &MultiError{errs: all}
+}
+
+// Append adds errs to err, which may be nil, and returns the combined error.
+// It is useful for collecting errors in a loop.
+func Append(err error, errs ...error) error {
+	return Join(append([]error{err}, errs...)...)
+}
+
+// Errors returns the errors held by err if it was returned from Join,
+// a slice holding just err if it is any other error, or nil if err is nil.
+func Errors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if m, ok := err.(*MultiError); ok {
+		return m.Errors()
+	}
+	return []error{err}
+}
+
+// Errors returns a copy of the errors.
+func (m *MultiError) Errors() []error {
+	return append([]error(nil), m.errs...)
+}
+
+// Error returns the messages of each error separated by "; ".
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.errs))
+	for i, err := range m.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors so Is and As can match any of them.
+func (m *MultiError) Unwrap() []error {
+	return m.errs
+}
+
+// LogValue implements slog.LogValuer, logging each error in a group keyed by its position.
+func (m *MultiError) LogValue() slog.Value {
+	attrs := make([]slog.Attr, len(m.errs))
+	for i, err := range m.errs {
+		attrs[i] = slog.Any(strconv.Itoa(i), err)
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,249 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// Keys of the fields set by the constructors in this package.
+// Use them with FieldOf to read the values back from an error.
+const (
+	IndexKey  = "index"
+	LengthKey = "length"
+	KeyKey    = "key"
+	ArgKey    = "arg"
+)
+
+// Field is a named value that explains an Error.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// Error is a structured error that wraps a sentinel such as IndexOutOfRangeErr
+// and carries the values that caused it, so callers can inspect them or log them
+// without parsing the message.
+// Unwrap returns its Kind, so Unwrap and Is find the sentinel as they do for errors made with fmt.Errorf and %w.
+// Is and As also match its Cause.
+type Error struct {
+	// Kind is the sentinel error this error wraps, such as IndexOutOfRangeErr.
+	Kind error
+	// Op is the name of the function that failed, such as "Item".
+	Op string
+	// Fields are the values that explain the error, in the order they were added.
+	Fields []Field
+	// Msg is an optional explanation added to the end of the message.
+	Msg string
+	// Cause is an optional underlying error.
+	Cause error
+
+	stack []uintptr
+}
+
+// Wrap creates an Error of the given kind that was returned by op.
+func Wrap(kind error, op string) *Error {
+	return &Error{Kind: kind, Op: op}
+}
+
+// IndexOutOfRange creates an Error wrapping IndexOutOfRangeErr with the index that was requested
+// and the length of the values it was requested from.
+func IndexOutOfRange(op string, index, length int) *Error {
+	return Wrap(IndexOutOfRangeErr, op).With(IndexKey, index).With(LengthKey, length)
+}
+
+// KeyNotFound creates an Error wrapping KeyNotFoundErr with the key that was not found.
+func KeyNotFound(op string, key any) *Error {
+	return Wrap(KeyNotFoundErr, op).With(KeyKey, key)
+}
+
+// NotFound creates an Error wrapping NotFoundErr.
+func NotFound(op string) *Error {
+	return Wrap(NotFoundErr, op)
+}
+
+// BadArgument creates an Error wrapping BadArgumentErr with the name of the argument that was not valid
+// and an explanation of why.
+func BadArgument(op, arg, msg string) *Error {
+	e := Wrap(BadArgumentErr, op).With(ArgKey, arg)
+	e.Msg = msg
+	return e
+}
+
+// With returns a copy of the error with a field added. The error itself is not changed.
+func (e *Error) With(key string, value any) *Error {
+	c := *e
+	c.Fields = append(slices.Clip(e.Fields), Field{Key: key, Value: value})
+	return &c
+}
+
+// Because returns a copy of the error with Cause set to err. The error itself is not changed.
+func (e *Error) Because(err error) *Error {
+	c := *e
+	c.Cause = err
+	return &c
+}
+
+// WithStack returns a copy of the error with the stack of the caller. The error itself is not changed.
+// Stacks are not captured unless this is called because it is expensive.
+func (e *Error) WithStack() *Error {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	c := *e
+	c.stack = pcs[:n]
+	return &c
+}
+
+// Field returns the value of the first field with the given key.
+func (e *Error) Field(key string) (any, bool) {
+	for _, f := range e.Fields {
+		if f.Key == key {
+			return f.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Stack returns the frames captured by WithStack or nil if no stack was captured.
+func (e *Error) Stack() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	var frames []runtime.Frame
+	fs := runtime.CallersFrames(e.stack)
+	for {
+		f, more := fs.Next()
+		frames = append(frames, f)
+		if !more {
+			return frames
+		}
+	}
+}
+
+// Error returns the message in the form "kind: Op(key=value, ...): Msg: Cause".
+func (e *Error) Error() string {
+	var b strings.Builder
+	if e.Kind != nil {
+		b.WriteString(e.Kind.Error())
+	}
+	if e.Op != "" || len(e.Fields) > 0 {
+		if b.Len() > 0 {
+			b.WriteString(": ")
+		}
+		b.WriteString(e.Op)
+		b.WriteByte('(')
+		for i, f := range e.Fields {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%s=%v", f.Key, f.Value)
+		}
+		b.WriteByte(')')
+	}
+	for _, s := range []string{e.Msg, causeString(e.Cause)} {
+		if s == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(": ")
+		}
+		b.WriteString(s)
+	}
+	return b.String()
+}
+
+func causeString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// Unwrap returns Kind, so Unwrap(err) gives the sentinel error.
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+// Is reports whether the Cause matches target, so Is finds the Cause as well as the Kind returned by Unwrap.
+func (e *Error) Is(target error) bool {
+	return e.Cause != nil && errors.Is(e.Cause, target)
+}
+
+// As finds the first error in the chain of the Cause that matches target, so As searches the Cause as well as the Kind returned by Unwrap.
+func (e *Error) As(target any) bool {
+	return e.Cause != nil && errors.As(e.Cause, target)
+}
+
+// Format implements fmt.Formatter.
+// The %+v verb prints the message followed by the captured stack, one frame per line.
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		io.WriteString(s, e.Error())
+		for _, f := range e.Stack() {
+			fmt.Fprintf(s, "\n%s\n\t%s:%d", f.Function, f.File, f.Line)
+		}
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		io.WriteString(s, e.Error())
+	}
+}
+
+// LogValue implements slog.LogValuer so the fields are logged as a group
+// instead of being flattened into the message.
+func (e *Error) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.String("msg", e.Error())}
+	if e.Kind != nil {
+		attrs = append(attrs, slog.String("kind", e.Kind.Error()))
+	}
+	if e.Op != "" {
+		attrs = append(attrs, slog.String("op", e.Op))
+	}
+	for _, f := range e.Fields {
+		attrs = append(attrs, slog.Any(f.Key, f.Value))
+	}
+	if e.Cause != nil {
+		attrs = append(attrs, slog.Any("cause", e.Cause))
+	}
+	if frames := e.Stack(); len(frames) > 0 {
+		stack := make([]string, len(frames))
+		for i, f := range frames {
+			stack[i] = fmt.Sprintf("%s %s:%d", f.Function, f.File, f.Line)
+		}
+		attrs = append(attrs, slog.Any("stack", stack))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// FieldOf searches the chain of err for an Error with a field of the given key and type
+// and returns its value.
+func FieldOf[T any](err error, key string) (T, bool) {
+	var zero T
+	if err == nil {
+		return zero, false
+	}
+	if e, ok := err.(*Error); ok {
+		if v, ok := e.Field(key); ok {
+			if t, ok := v.(T); ok {
+				return t, true
+			}
+		}
+		return FieldOf[T](e.Cause, key)
+	}
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return FieldOf[T](u.Unwrap(), key)
+	case interface{ Unwrap() []error }:
+		for _, inner := range u.Unwrap() {
+			if t, ok := FieldOf[T](inner, key); ok {
+				return t, true
+			}
+		}
+	}
+	return zero, false
+}
@@ -0,0 +1,117 @@
+package errors_test
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+func ExampleIndexOutOfRange() {
+	_, err := list.Item(5, []int{1, 2, 3})
+	index, _ := errors.FieldOf[int](err, errors.IndexKey)
+	length, _ := errors.FieldOf[int](err, errors.LengthKey)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, errors.IndexOutOfRangeErr), index, length)
+	// Output:
+	// index out of range: Item(index=5, length=3)
+	// true 5 3
+}
+
+func ExampleBadArgument() {
+	err := errors.BadArgument("Parse", "s", "must not be empty")
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, errors.BadArgumentErr))
+	// Output:
+	// bad argument: Parse(arg=s): must not be empty
+	// true
+}
+
+func ExampleError_Because() {
+	cause := errors.New("disk full")
+	err := errors.Wrap(errors.NotFoundErr, "Load").With("name", "config").Because(cause)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, errors.NotFoundErr), errors.Is(err, cause))
+	// Output:
+	// not found: Load(name=config): disk full
+	// true true
+}
+
+func ExampleError_Unwrap() {
+	_, err := list.Item(5, []int{1, 2, 3})
+	fmt.Println(errors.Unwrap(err) == errors.IndexOutOfRangeErr)
+	// Output: true
+}
+
+func ExampleError_With() {
+	base := errors.Wrap(errors.NotFoundErr, "Load")
+	a, b := base.With("name", "a"), base.With("name", "b")
+	fmt.Println(base)
+	fmt.Println(a)
+	fmt.Println(b)
+	// Output:
+	// not found: Load()
+	// not found: Load(name=a)
+	// not found: Load(name=b)
+}
+
+func ExampleError_WithStack() {
+	err := errors.KeyNotFound("Lookup", "a").WithStack()
+	frames := err.Stack()
+	fmt.Println(strings.HasSuffix(frames[0].Function, "ExampleError_WithStack"))
+	fmt.Println(strings.Contains(fmt.Sprintf("%+v", err), "structured_test.go"))
+	fmt.Println(errors.KeyNotFound("Lookup", "a").Stack() == nil)
+	// Output:
+	// true
+	// true
+	// true
+}
+
+func ExampleError_LogValue() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Error("failed", "err", errors.IndexOutOfRange("Item", 5, 3))
+	// Output:
+	// level=ERROR msg=failed err.msg="index out of range: Item(index=5, length=3)" err.kind="index out of range" err.op=Item err.index=5 err.length=3
+}
+
+func ExampleJoin() {
+	err := errors.Join(
+		nil,
+		errors.KeyNotFound("Find", "a"),
+		errors.Join(errors.IndexOutOfRange("Item", 3, 2)),
+	)
+	key, _ := errors.FieldOf[string](err, errors.KeyKey)
+	fmt.Println(err)
+	fmt.Println(len(errors.Errors(err)), key)
+	fmt.Println(errors.Is(err, errors.KeyNotFoundErr), errors.Is(err, errors.IndexOutOfRangeErr), errors.Is(err, errors.NotFoundErr))
+	fmt.Println(errors.Join(nil, nil) == nil)
+	// Output:
+	// key not found: Find(key=a); index out of range: Item(index=3, length=2)
+	// 2 a
+	// true true false
+	// true
+}
+
+func ExampleAppend() {
+	var err error
+	for _, s := range []string{"a", "", "b", ""} {
+		if s == "" {
+			err = errors.Append(err, errors.BadArgument("Check", "s", "must not be empty"))
+		}
+	}
+	fmt.Println(len(errors.Errors(err)))
+	fmt.Println(errors.As(err, new(*errors.Error)))
+	// Output:
+	// 2
+	// true
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+// StronglyConnectedComponents returns the groups of nodes in which every node can be reached from every other node.
+// Each group is in the order its nodes were added, and the groups are in the order of their first node.
+// In an undirected graph, these are the same as the ConnectedComponents.
+func (g *Graph[N, W]) StronglyConnectedComponents() [][]N {
+	// Tarjan's algorithm.
+	index := make(map[N]int, len(g.nodes))
+	low := make(map[N]int, len(g.nodes))
+	onStack := make(map[N]bool, len(g.nodes))
+	var stack []N
+	component := make(map[N]int, len(g.nodes))
+	count := 0
+
+	var connect func(n N)
+	connect = func(n N) {
+		index[n] = len(index)
+		low[n] = index[n]
+		stack = append(stack, n)
+		onStack[n] = true
+		for _, e := range g.out[n] {
+			if _, ok := index[e.To]; !ok {
+				connect(e.To)
+				low[n] = min(low[n], low[e.To])
+			} else if onStack[e.To] {
+				low[n] = min(low[n], index[e.To])
+			}
+		}
+		if low[n] != index[n] {
+			return
+		}
+		for {
+			m := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[m] = false
+			component[m] = count
+			if m == n {
+				break
+			}
+		}
+		count++
+	}
+
+	for _, n := range g.nodes {
+		if _, ok := index[n]; !ok {
+			connect(n)
+		}
+	}
+	return groupBy(g.nodes, component)
+}
+
+// ConnectedComponents returns the groups of nodes that are connected to each other, ignoring the direction of edges.
+// Each group is in the order its nodes were added, and the groups are in the order of their first node.
+func (g *Graph[N, W]) ConnectedComponents() [][]N {
+	u := newUnionFind(g.nodes)
+	for _, e := range g.Edges() {
+		u.union(e.From, e.To)
+	}
+	component := make(map[N]int, len(g.nodes))
+	for _, n := range g.nodes {
+		component[n] = u.index[u.find(n)]
+	}
+	return groupBy(g.nodes, component)
+}
+
+// groupBy puts nodes into groups by their component number, keeping the order of nodes
+// and ordering the groups by their first node.
+func groupBy[N comparable](nodes []N, component map[N]int) [][]N {
+	var output [][]N
+	position := make(map[int]int)
+	for _, n := range nodes {
+		c := component[n]
+		p, ok := position[c]
+		if !ok {
+			p = len(output)
+			position[c] = p
+			output = append(output, nil)
+		}
+		output[p] = append(output[p], n)
+	}
+	return output
+}
+
+type unionFind[N comparable] struct {
+	parent map[N]N
+	index  map[N]int
+}
+
+func newUnionFind[N comparable](nodes []N) *unionFind[N] {
+	u := &unionFind[N]{parent: make(map[N]N, len(nodes)), index: make(map[N]int, len(nodes))}
+	for i, n := range nodes {
+		u.parent[n] = n
+		u.index[n] = i
+	}
+	return u
+}
+
+func (u *unionFind[N]) find(n N) N {
+	for u.parent[n] != n {
+		u.parent[n] = u.parent[u.parent[n]]
+		n = u.parent[n]
+	}
+	return n
+}
+
+// union joins the sets holding a and b and reports whether they were separate.
+// The root is always the node that was added first, which keeps the results in a stable order.
+func (u *unionFind[N]) union(a, b N) bool {
+	ra, rb := u.find(a), u.find(b)
+	if ra == rb {
+		return false
+	}
+	if u.index[rb] < u.index[ra] {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+	return true
+}
+
+// MinimumSpanningTree returns a new undirected graph with every node of g and the edges of g with the lowest
+// total weight that connect them. If g is not connected, it returns a spanning tree for each connected component.
+// Edges with equal weights are chosen in the order they were added.
+// It returns a BadArgumentErr if g is directed.
+func (g *Graph[N, W]) MinimumSpanningTree() (*Graph[N, W], error) {
+	if g.directed {
+		return nil, errors.BadArgument("MinimumSpanningTree", "g", "graph is directed")
+	}
+	// Kruskal's algorithm.
+	edges := g.Edges()
+	slices.SortStableFunc(edges, func(a, b Edge[N, W]) int { return cmp.Compare(a.Weight, b.Weight) })
+	tree := NewUndirected[N, W]()
+	list.Iter(tree.AddNode, g.nodes)
+	u := newUnionFind(g.nodes)
+	for _, e := range edges {
+		if u.union(e.From, e.To) {
+			tree.AddEdge(e.From, e.To, e.Weight)
+		}
+	}
+	return tree, nil
+}
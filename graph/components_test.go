@@ -0,0 +1,41 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/graph"
+)
+
+func ExampleGraph_StronglyConnectedComponents() {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	g.AddEdge("e", "d")
+	g.AddNode("f")
+	fmt.Println(g.StronglyConnectedComponents())
+	fmt.Println(g.ConnectedComponents())
+	// Output:
+	// [[a b c] [d e] [f]]
+	// [[a b c d e] [f]]
+}
+
+func ExampleGraph_MinimumSpanningTree() {
+	g := graph.NewUndirected[string, int]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("b", "c", 2)
+	g.AddEdge("c", "d", 5)
+	g.AddEdge("b", "d", 3)
+	g.AddEdge("x", "y", 7)
+	tree, _ := g.MinimumSpanningTree()
+	fmt.Println(tree.Edges())
+
+	_, err := graph.NewDirected[string, int]().MinimumSpanningTree()
+	fmt.Println(err)
+	// Output:
+	// [{a c 1} {b c 2} {b d 3} {x y 7}]
+	// bad argument: MinimumSpanningTree(arg=g): graph is directed
+}
@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/flowonyx/functional/list"
+)
+
+type dotConfig[N comparable] struct {
+	name        string
+	label       func(N) string
+	hideWeights bool
+}
+
+// DOTOption is an option for ToDOT and WriteDOT.
+type DOTOption[N comparable] func(*dotConfig[N])
+
+// DOTName sets the name of the graph in the output. The default is "G".
+func DOTName[N comparable](name string) DOTOption[N] {
+	return func(c *dotConfig[N]) { c.name = name }
+}
+
+// DOTLabel sets the function that gives the label of each node. The default formats the node with fmt.Sprint.
+func DOTLabel[N comparable](label func(N) string) DOTOption[N] {
+	return func(c *dotConfig[N]) { c.label = label }
+}
+
+// DOTHideWeights leaves the weights of edges out of the output, which suits graphs without weights.
+func DOTHideWeights[N comparable]() DOTOption[N] {
+	return func(c *dotConfig[N]) { c.hideWeights = true }
+}
+
+// WriteDOT writes the graph to w in the DOT language used by Graphviz.
+// Nodes are written in the order they were added, followed by the edges with their weights as labels.
+// Each node gets an ID from its position, n0, n1 and so on, and its label is set as an attribute,
+// so nodes with the same label stay separate.
+func (g *Graph[N, W]) WriteDOT(w io.Writer, opts ...DOTOption[N]) error {
+	config := dotConfig[N]{name: "G", label: func(n N) string { return fmt.Sprint(n) }}
+	list.Iter(func(opt DOTOption[N]) { opt(&config) }, opts)
+
+	kind, arrow := "graph", "--"
+	if g.directed {
+		kind, arrow = "digraph", "->"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s {\n", kind, dotQuote(config.name))
+	ids := make(map[N]int, len(g.nodes))
+	for i, n := range g.nodes {
+		ids[n] = i
+		fmt.Fprintf(&b, "\tn%d [label=%s];\n", i, dotQuote(config.label(n)))
+	}
+	for _, e := range g.Edges() {
+		fmt.Fprintf(&b, "\tn%d %s n%d", ids[e.From], arrow, ids[e.To])
+		if !config.hideWeights {
+			fmt.Fprintf(&b, " [label=%s]", dotQuote(fmt.Sprint(e.Weight)))
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote quotes s as a DOT string. Only " and \ are escaped, as DOT does not understand Go escapes such as \u.
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// ToDOT returns the graph in the DOT language used by Graphviz. See WriteDOT.
+func (g *Graph[N, W]) ToDOT(opts ...DOTOption[N]) string {
+	var b strings.Builder
+	_ = g.WriteDOT(&b, opts...)
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/graph"
+)
+
+func ExampleGraph_ToDOT() {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b", 3)
+	g.AddEdge("b", "c \"quoted\"", 1)
+	fmt.Print(g.ToDOT())
+	// Output:
+	// digraph "G" {
+	// 	n0 [label="a"];
+	// 	n1 [label="b"];
+	// 	n2 [label="c \"quoted\""];
+	// 	n0 -> n1 [label="3"];
+	// 	n1 -> n2 [label="1"];
+	// }
+}
+
+func ExampleDOTHideWeights() {
+	g := graph.NewUndirected[int, int]()
+	g.AddEdge(1, 2)
+	fmt.Print(g.ToDOT(
+		graph.DOTName[int]("numbers"),
+		graph.DOTLabel(func(n int) string { return fmt.Sprintf("n%d", n) }),
+		graph.DOTHideWeights[int](),
+	))
+	// Output:
+	// graph "numbers" {
+	// 	n0 [label="n1"];
+	// 	n1 [label="n2"];
+	// 	n0 -- n1;
+	// }
+}
+
+func ExampleDOTLabel() {
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 4)
+	fmt.Print(g.ToDOT(
+		graph.DOTLabel(func(n int) string {
+			if n%2 == 1 {
+				return `odd \ é`
+			}
+			return "even"
+		}),
+		graph.DOTHideWeights[int](),
+	))
+	// Output:
+	// digraph "G" {
+	// 	n0 [label="odd \\ é"];
+	// 	n1 [label="even"];
+	// 	n2 [label="odd \\ é"];
+	// 	n3 [label="even"];
+	// 	n0 -> n1;
+	// 	n2 -> n3;
+	// }
+}
@@ -0,0 +1,228 @@
+// Package graph provides a generic graph type with directed or undirected, weighted edges
+// and common graph algorithms such as searches, topological sorting and shortest paths.
+package graph
+
+import (
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+	"golang.org/x/exp/constraints"
+)
+
+// Weight is the type of the weights of edges.
+type Weight interface {
+	constraints.Integer | constraints.Float
+}
+
+// Edge is a connection from one node to another with a weight.
+// In an undirected graph, an Edge can be followed in either direction.
+type Edge[N comparable, W Weight] struct {
+	From   N
+	To     N
+	Weight W
+}
+
+// Graph is a set of nodes identified by values of any comparable type and the edges between them.
+// Nodes and edges are kept in the order they were added, so every algorithm gives the same answer each time.
+// A graph without weights can use any Weight type and add edges without giving a weight, which gives them a weight of 1.
+type Graph[N comparable, W Weight] struct {
+	directed bool
+	nodes    []N
+	out      map[N][]Edge[N, W]
+}
+
+// NewDirected creates an empty graph where edges go only from one node to the other.
+func NewDirected[N comparable, W Weight]() *Graph[N, W] {
+	return &Graph[N, W]{directed: true, out: make(map[N][]Edge[N, W])}
+}
+
+// NewUndirected creates an empty graph where edges go both ways.
+func NewUndirected[N comparable, W Weight]() *Graph[N, W] {
+	return &Graph[N, W]{out: make(map[N][]Edge[N, W])}
+}
+
+// IsDirected tests whether edges go only from one node to the other.
+func (g *Graph[N, W]) IsDirected() bool {
+	return g.directed
+}
+
+// AddNode adds a node to the graph if it is not already present.
+func (g *Graph[N, W]) AddNode(node N) {
+	if _, ok := g.out[node]; ok {
+		return
+	}
+	g.nodes = append(g.nodes, node)
+	g.out[node] = nil
+}
+
+// AddEdge adds an edge between two nodes, adding the nodes if they are not already present.
+// If weight is not given, the edge has a weight of 1. If the edge already exists, its weight is replaced.
+func (g *Graph[N, W]) AddEdge(from, to N, weight ...W) {
+	w := W(1)
+	if len(weight) > 0 {
+		w = weight[0]
+	}
+	g.AddNode(from)
+	g.AddNode(to)
+	g.setEdge(from, to, w)
+	if !g.directed && from != to {
+		g.setEdge(to, from, w)
+	}
+}
+
+func (g *Graph[N, W]) setEdge(from, to N, w W) {
+	edges := g.out[from]
+	if i := list.IndexBy(func(e Edge[N, W]) bool { return e.To == to }, edges); i >= 0 {
+		edges[i].Weight = w
+		return
+	}
+	g.out[from] = append(edges, Edge[N, W]{From: from, To: to, Weight: w})
+}
+
+func (g *Graph[N, W]) removeEdge(from, to N) bool {
+	edges := g.out[from]
+	i := list.IndexBy(func(e Edge[N, W]) bool { return e.To == to }, edges)
+	if i < 0 {
+		return false
+	}
+	g.out[from] = append(edges[:i:i], edges[i+1:]...)
+	return true
+}
+
+// RemoveEdge removes the edge between two nodes and reports whether it was present.
+func (g *Graph[N, W]) RemoveEdge(from, to N) bool {
+	removed := g.removeEdge(from, to)
+	if removed && !g.directed {
+		g.removeEdge(to, from)
+	}
+	return removed
+}
+
+// RemoveNode removes a node and every edge to or from it and reports whether it was present.
+func (g *Graph[N, W]) RemoveNode(node N) bool {
+	if !g.HasNode(node) {
+		return false
+	}
+	delete(g.out, node)
+	g.nodes = list.Filter(func(n N) bool { return n != node }, g.nodes...)
+	for _, n := range g.nodes {
+		g.removeEdge(n, node)
+	}
+	return true
+}
+
+// HasNode tests whether the node is in the graph.
+func (g *Graph[N, W]) HasNode(node N) bool {
+	_, ok := g.out[node]
+	return ok
+}
+
+// HasEdge tests whether there is an edge from one node to the other.
+func (g *Graph[N, W]) HasEdge(from, to N) bool {
+	return g.Weight(from, to).IsSome()
+}
+
+// Weight returns the weight of the edge from one node to the other, or None if there is no such edge.
+func (g *Graph[N, W]) Weight(from, to N) option.Option[W] {
+	for _, e := range g.out[from] {
+		if e.To == to {
+			return option.Some(e.Weight)
+		}
+	}
+	return option.None[W]()
+}
+
+// Nodes returns the nodes in the order they were added.
+func (g *Graph[N, W]) Nodes() []N {
+	return list.Map(func(n N) N { return n }, g.nodes)
+}
+
+// Len returns the number of nodes.
+func (g *Graph[N, W]) Len() int {
+	return len(g.nodes)
+}
+
+// Edges returns the edges in the order of the nodes they come from.
+// In an undirected graph, each edge is only returned once.
+func (g *Graph[N, W]) Edges() []Edge[N, W] {
+	var output []Edge[N, W]
+	seen := make(map[Edge[N, W]]bool)
+	for _, n := range g.nodes {
+		for _, e := range g.out[n] {
+			if !g.directed {
+				if seen[Edge[N, W]{From: e.To, To: e.From, Weight: e.Weight}] {
+					continue
+				}
+				seen[e] = true
+			}
+			output = append(output, e)
+		}
+	}
+	return output
+}
+
+// EdgesFrom returns the edges that leave the node. In an undirected graph, these are all of its edges.
+func (g *Graph[N, W]) EdgesFrom(node N) []Edge[N, W] {
+	return list.Map(func(e Edge[N, W]) Edge[N, W] { return e }, g.out[node])
+}
+
+// Neighbors returns the nodes that can be reached from the node by following one edge.
+func (g *Graph[N, W]) Neighbors(node N) []N {
+	return list.Map(func(e Edge[N, W]) N { return e.To }, g.out[node])
+}
+
+// OutDegree returns the number of edges that leave the node.
+func (g *Graph[N, W]) OutDegree(node N) int {
+	return len(g.out[node])
+}
+
+// InDegree returns the number of edges that arrive at the node.
+// In an undirected graph, this is the same as OutDegree.
+func (g *Graph[N, W]) InDegree(node N) int {
+	if !g.directed {
+		return g.OutDegree(node)
+	}
+	count := 0
+	for _, n := range g.nodes {
+		if g.HasEdge(n, node) {
+			count++
+		}
+	}
+	return count
+}
+
+// Reverse returns a copy of a directed graph with the direction of every edge reversed.
+// The copy of an undirected graph is the same as the original.
+func (g *Graph[N, W]) Reverse() *Graph[N, W] {
+	r := &Graph[N, W]{directed: g.directed, out: make(map[N][]Edge[N, W])}
+	list.Iter(r.AddNode, g.nodes)
+	for _, e := range g.Edges() {
+		if g.directed {
+			r.AddEdge(e.To, e.From, e.Weight)
+		} else {
+			r.AddEdge(e.From, e.To, e.Weight)
+		}
+	}
+	return r
+}
+
+// PathWeight returns the total weight of the edges along path.
+// It returns a NotFoundErr if two nodes next to each other in path are not connected by an edge.
+func (g *Graph[N, W]) PathWeight(path []N) (W, error) {
+	var total W
+	for i := 1; i < len(path); i++ {
+		w := g.Weight(path[i-1], path[i])
+		if w.IsNone() {
+			return 0, errors.Wrap(errors.NotFoundErr, "PathWeight").With("from", path[i-1]).With("to", path[i])
+		}
+		total += w.Value()
+	}
+	return total, nil
+}
+
+func (g *Graph[N, W]) checkNode(op string, node N) error {
+	if !g.HasNode(node) {
+		return errors.KeyNotFound(op, node)
+	}
+	return nil
+}
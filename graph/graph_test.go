@@ -0,0 +1,70 @@
+package graph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional/graph"
+)
+
+func ExampleGraph() {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("a", "c")
+	g.AddEdge("c", "b", 4)
+	g.AddNode("d")
+	fmt.Println(g.Nodes(), g.Len())
+	fmt.Println(g.Neighbors("a"), g.HasEdge("b", "a"), g.Weight("c", "b"))
+	fmt.Println(g.InDegree("b"), g.OutDegree("a"))
+	g.RemoveNode("c")
+	fmt.Println(g.Edges())
+	// Output:
+	// [a b c d] 4
+	// [b c] false Some(4)
+	// 2 2
+	// [{a b 2}]
+}
+
+func ExampleNewUndirected() {
+	g := graph.NewUndirected[string, float64]()
+	g.AddEdge("a", "b", 1.5)
+	g.AddEdge("b", "c", 2)
+	fmt.Println(g.Neighbors("b"), g.HasEdge("b", "a"))
+	fmt.Println(g.Edges())
+	fmt.Println(g.PathWeight([]string{"c", "b", "a"}))
+	// Output:
+	// [a c] true
+	// [{a b 1.5} {b c 2}]
+	// 3.5 <nil>
+}
+
+func ExampleGraph_Reverse() {
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	fmt.Println(g.Reverse().Edges())
+	// Output: [{2 1 1} {3 2 1}]
+}
+
+func TestAddEdgeReplacesWeight(t *testing.T) {
+	g := graph.NewUndirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "a", 5)
+	if got := g.Edges(); len(got) != 1 || got[0].Weight != 5 {
+		t.Errorf("Edges() = %v, want one edge with weight 5", got)
+	}
+	if w := g.Weight("a", "b").Value(); w != 5 {
+		t.Errorf("Weight(a, b) = %d, want 5", w)
+	}
+	if !g.RemoveEdge("a", "b") || g.HasEdge("b", "a") {
+		t.Error("RemoveEdge(a, b) should remove the edge both ways")
+	}
+}
+
+func TestPathWeightMissingEdge(t *testing.T) {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b")
+	if _, err := g.PathWeight([]string{"b", "a"}); err == nil {
+		t.Error("PathWeight should fail when there is no edge")
+	}
+}
@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"cmp"
+
+	"github.com/flowonyx/functional/collections"
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
+// Paths holds the shortest paths from a source node to every node that can be reached from it.
+type Paths[N comparable, W Weight] struct {
+	source N
+	dist   map[N]W
+	prev   map[N]N
+}
+
+// Source returns the node the paths start from.
+func (p Paths[N, W]) Source() N {
+	return p.source
+}
+
+// DistanceTo returns the total weight of the shortest path to the node, or None if it cannot be reached.
+func (p Paths[N, W]) DistanceTo(node N) option.Option[W] {
+	if d, ok := p.dist[node]; ok {
+		return option.Some(d)
+	}
+	return option.None[W]()
+}
+
+// PathTo returns the nodes along the shortest path from the source to the node, including both ends,
+// or None if it cannot be reached.
+func (p Paths[N, W]) PathTo(node N) option.Option[[]N] {
+	if _, ok := p.dist[node]; !ok {
+		return option.None[[]N]()
+	}
+	path := []N{node}
+	for node != p.source {
+		node = p.prev[node]
+		path = append(path, node)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return option.Some(path)
+}
+
+func newPaths[N comparable, W Weight](source N) Paths[N, W] {
+	return Paths[N, W]{source: source, dist: map[N]W{source: 0}, prev: make(map[N]N)}
+}
+
+type queued[N comparable, W Weight] struct {
+	node     N
+	priority W
+}
+
+func compareQueued[N comparable, W Weight](a, b queued[N, W]) int {
+	return cmp.Compare(a.priority, b.priority)
+}
+
+// search finds the shortest paths from source with the estimate of the remaining distance given by heuristic,
+// stopping early when target is reached.
+func (g *Graph[N, W]) search(op string, source N, target option.Option[N], heuristic func(N) W) (Paths[N, W], error) {
+	if err := g.checkNode(op, source); err != nil {
+		return Paths[N, W]{}, err
+	}
+	for _, e := range g.Edges() {
+		if e.Weight < 0 {
+			return Paths[N, W]{}, errors.BadArgument(op, "g", "graph has a negative weight").With("from", e.From).With("to", e.To)
+		}
+	}
+	paths := newPaths[N, W](source)
+	queue := collections.NewPriorityQueue(compareQueued[N, W])
+	handles := map[N]*collections.Handle[queued[N, W]]{
+		source: queue.Push(queued[N, W]{source, heuristic(source)}),
+	}
+	for !queue.IsEmpty() {
+		q, _ := queue.Pop()
+		if target.IsSome() && q.node == target.Value() {
+			break
+		}
+		for _, e := range g.out[q.node] {
+			d := paths.dist[q.node] + e.Weight
+			if old, ok := paths.dist[e.To]; ok && old <= d {
+				continue
+			}
+			paths.dist[e.To] = d
+			paths.prev[e.To] = q.node
+			next := queued[N, W]{e.To, d + heuristic(e.To)}
+			if h, ok := handles[e.To]; ok && h.InQueue() {
+				_ = queue.Update(h, next)
+			} else {
+				handles[e.To] = queue.Push(next)
+			}
+		}
+	}
+	return paths, nil
+}
+
+// Dijkstra finds the shortest paths from source to every node that can be reached from it.
+// It returns a KeyNotFoundErr if source is not in the graph
+// or a BadArgumentErr if any edge has a negative weight; use BellmanFord for those graphs.
+func (g *Graph[N, W]) Dijkstra(source N) (Paths[N, W], error) {
+	return g.search("Dijkstra", source, option.None[N](), func(N) W { return 0 })
+}
+
+// ShortestPath returns the nodes along the shortest path from one node to the other, including both ends,
+// or None if there is no path. It uses Dijkstra, so it also returns None if any edge has a negative weight.
+func (g *Graph[N, W]) ShortestPath(from, to N) option.Option[[]N] {
+	paths, err := g.Dijkstra(from)
+	if err != nil {
+		return option.None[[]N]()
+	}
+	return paths.PathTo(to)
+}
+
+// AStar returns the nodes along the shortest path from one node to the other, including both ends,
+// or None if there is no path.
+// heuristic estimates the distance from a node to the target. It guides the search toward the target
+// and must never return more than the real distance for the path found to be the shortest.
+// AStar returns a KeyNotFoundErr if from is not in the graph
+// or a BadArgumentErr if any edge has a negative weight.
+func (g *Graph[N, W]) AStar(from, to N, heuristic func(N) W) (option.Option[[]N], error) {
+	paths, err := g.search("AStar", from, option.Some(to), heuristic)
+	if err != nil {
+		return option.None[[]N](), err
+	}
+	return paths.PathTo(to), nil
+}
+
+// BellmanFord finds the shortest paths from source to every node that can be reached from it.
+// Unlike Dijkstra, edges can have negative weights.
+// It returns a KeyNotFoundErr if source is not in the graph or a NegativeCycleErr
+// holding the cycle if a cycle with a negative total weight can be reached from source,
+// since then there is no shortest path. In an undirected graph, every edge with a negative weight is such a cycle.
+func (g *Graph[N, W]) BellmanFord(source N) (Paths[N, W], error) {
+	if err := g.checkNode("BellmanFord", source); err != nil {
+		return Paths[N, W]{}, err
+	}
+	paths := newPaths[N, W](source)
+	relax := func() option.Option[N] {
+		changed := option.None[N]()
+		for _, n := range g.nodes {
+			dn, ok := paths.dist[n]
+			if !ok {
+				continue
+			}
+			for _, e := range g.out[n] {
+				if d, ok := paths.dist[e.To]; !ok || dn+e.Weight < d {
+					paths.dist[e.To] = dn + e.Weight
+					paths.prev[e.To] = n
+					changed = option.Some(e.To)
+				}
+			}
+		}
+		return changed
+	}
+	for i := 1; i < len(g.nodes); i++ {
+		if relax().IsNone() {
+			return paths, nil
+		}
+	}
+	changed := relax()
+	if changed.IsNone() {
+		return paths, nil
+	}
+	// Following the predecessors len(nodes) times is sure to end up inside the cycle.
+	n := changed.Value()
+	for range g.nodes {
+		n = paths.prev[n]
+	}
+	cycle := []N{n}
+	for m := paths.prev[n]; m != n; m = paths.prev[m] {
+		cycle = append(cycle, m)
+	}
+	cycle = append(cycle, n)
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return Paths[N, W]{}, errors.Wrap(NegativeCycleErr, "BellmanFord").With(CycleKey, cycle)
+}
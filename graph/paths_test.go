@@ -0,0 +1,133 @@
+package graph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/graph"
+)
+
+func roads() *graph.Graph[string, int] {
+	g := graph.NewUndirected[string, int]()
+	g.AddEdge("home", "park", 4)
+	g.AddEdge("home", "shop", 1)
+	g.AddEdge("shop", "park", 2)
+	g.AddEdge("park", "work", 5)
+	g.AddEdge("shop", "work", 9)
+	g.AddNode("island")
+	return g
+}
+
+func ExampleGraph_Dijkstra() {
+	paths, _ := roads().Dijkstra("home")
+	fmt.Println(paths.PathTo("work"), paths.DistanceTo("work"))
+	fmt.Println(paths.PathTo("island"), paths.DistanceTo("island"))
+	// Output:
+	// Some([home shop park work]) Some(8)
+	// None None
+}
+
+func ExampleGraph_ShortestPath() {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("a", "c")
+	fmt.Println(g.ShortestPath("a", "c"))
+	fmt.Println(g.ShortestPath("c", "a"))
+	// Output:
+	// Some([a c])
+	// None
+}
+
+type point struct{ x, y int }
+
+func ExampleGraph_AStar() {
+	// A 4x4 grid with a wall in the middle column except at the top.
+	g := graph.NewUndirected[point, int]()
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 4; y++ {
+			if x == 2 && y > 0 {
+				continue
+			}
+			if x+1 < 4 && !(x+1 == 2 && y > 0) {
+				g.AddEdge(point{x, y}, point{x + 1, y})
+			}
+			if y+1 < 4 && !(x == 2) {
+				g.AddEdge(point{x, y}, point{x, y + 1})
+			}
+		}
+	}
+	target := point{3, 3}
+	manhattan := func(p point) int { return max(target.x-p.x, p.x-target.x) + max(target.y-p.y, p.y-target.y) }
+	path, _ := g.AStar(point{0, 3}, target, manhattan)
+	fmt.Println(path)
+	fmt.Println(g.PathWeight(path.Value()))
+	// Output:
+	// Some([{0 3} {1 3} {1 2} {1 1} {1 0} {2 0} {3 0} {3 1} {3 2} {3 3}])
+	// 9 <nil>
+}
+
+func ExampleGraph_BellmanFord() {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 2)
+	g.AddEdge("b", "c", -3)
+	g.AddEdge("c", "d", 1)
+	paths, _ := g.BellmanFord("a")
+	fmt.Println(paths.PathTo("d"), paths.DistanceTo("d"))
+
+	g.AddEdge("d", "b", 1)
+	_, err := g.BellmanFord("a")
+	fmt.Println(errors.Is(err, graph.NegativeCycleErr))
+	cycle, _ := errors.FieldOf[[]string](err, graph.CycleKey)
+	fmt.Println(len(cycle), cycle[0] == cycle[len(cycle)-1])
+	// Output:
+	// Some([a b c d]) Some(2)
+	// true
+	// 4 true
+}
+
+func TestDijkstraErrors(t *testing.T) {
+	g := roads()
+	if _, err := g.Dijkstra("nowhere"); !errors.Is(err, errors.KeyNotFoundErr) {
+		t.Errorf("Dijkstra(nowhere) error = %v, want KeyNotFoundErr", err)
+	}
+	g.AddEdge("work", "home", -1)
+	if _, err := g.Dijkstra("home"); !errors.Is(err, errors.BadArgumentErr) {
+		t.Errorf("Dijkstra with a negative weight error = %v, want BadArgumentErr", err)
+	}
+	if g.ShortestPath("home", "work").IsSome() {
+		t.Error("ShortestPath with a negative weight should be None")
+	}
+}
+
+func TestDijkstraMatchesBellmanFord(t *testing.T) {
+	g := graph.NewDirected[int, int]()
+	for i := 0; i < 30; i++ {
+		for j := 0; j < 30; j++ {
+			if (i*7+j*13)%5 == 0 && i != j {
+				g.AddEdge(i, j, (i*j)%11+1)
+			}
+		}
+	}
+	d, err := g.Dijkstra(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := g.BellmanFord(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range g.Nodes() {
+		if dd, bd := d.DistanceTo(n), b.DistanceTo(n); dd.String() != bd.String() {
+			t.Errorf("DistanceTo(%d): Dijkstra %v, BellmanFord %v", n, dd, bd)
+		}
+		if p := d.PathTo(n); p.IsSome() {
+			w, err := g.PathWeight(p.Value())
+			if err != nil || w != d.DistanceTo(n).Value() {
+				t.Errorf("PathTo(%d) = %v has weight %d, want %v", n, p, w, d.DistanceTo(n))
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
+// CycleErr is returned by TopologicalSort when the graph has a cycle.
+// The nodes of the cycle can be found with errors.FieldOf[[]N](err, CycleKey).
+const CycleErr = errors.FunctionalError("graph has a cycle")
+
+// NegativeCycleErr is returned by BellmanFord when a cycle with a negative total weight can be reached from the source.
+// The nodes of the cycle can be found with errors.FieldOf[[]N](err, CycleKey).
+const NegativeCycleErr = errors.FunctionalError("graph has a negative cycle")
+
+// CycleKey is the key of the field that holds the nodes of a cycle in a CycleErr or NegativeCycleErr.
+// The first node of the cycle is repeated at the end.
+const CycleKey = "cycle"
+
+// TopologicalSort returns the nodes of a directed graph ordered so that every edge goes from
+// an earlier node to a later one. Nodes that could go in either order keep the order they were added.
+// It returns a CycleErr holding one of the cycles if there is no such order,
+// or a BadArgumentErr if the graph is undirected.
+func (g *Graph[N, W]) TopologicalSort() ([]N, error) {
+	if !g.directed {
+		return nil, errors.BadArgument("TopologicalSort", "g", "graph is undirected")
+	}
+	inDegree := make(map[N]int, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, e := range g.out[n] {
+			inDegree[e.To]++
+		}
+	}
+	var ready []N
+	for _, n := range g.nodes {
+		if inDegree[n] == 0 {
+			ready = append(ready, n)
+		}
+	}
+	output := make([]N, 0, len(g.nodes))
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		output = append(output, n)
+		for _, e := range g.out[n] {
+			inDegree[e.To]--
+			if inDegree[e.To] == 0 {
+				ready = append(ready, e.To)
+			}
+		}
+	}
+	if len(output) < len(g.nodes) {
+		return nil, errors.Wrap(CycleErr, "TopologicalSort").With(CycleKey, g.FindCycle().Value())
+	}
+	return output, nil
+}
+
+// FindCycle returns the nodes of a cycle in the graph with the first node repeated at the end,
+// or None if there are no cycles. In an undirected graph, an edge followed back the way it came is not a cycle.
+func (g *Graph[N, W]) FindCycle() option.Option[[]N] {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[N]int, len(g.nodes))
+	parent := make(map[N]N, len(g.nodes))
+	var cycle []N
+
+	var visit func(n N) bool
+	visit = func(n N) bool {
+		state[n] = visiting
+		for _, e := range g.out[n] {
+			if !g.directed && e.To != n {
+				if p, ok := parent[n]; ok && p == e.To {
+					continue
+				}
+			}
+			switch state[e.To] {
+			case visiting:
+				cycle = []N{e.To}
+				for m := n; m != e.To; m = parent[m] {
+					cycle = append(cycle, m)
+				}
+				for i, j := 1, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				cycle = append(cycle, e.To)
+				return true
+			case unvisited:
+				parent[e.To] = n
+				if visit(e.To) {
+					return true
+				}
+			}
+		}
+		state[n] = done
+		return false
+	}
+
+	for _, n := range g.nodes {
+		if state[n] == unvisited && visit(n) {
+			return option.Some(cycle)
+		}
+	}
+	return option.None[[]N]()
+}
+
+// IsAcyclic tests whether the graph has no cycles.
+func (g *Graph[N, W]) IsAcyclic() bool {
+	return g.FindCycle().IsNone()
+}
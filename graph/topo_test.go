@@ -0,0 +1,39 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/graph"
+)
+
+func ExampleGraph_TopologicalSort() {
+	deps := graph.NewDirected[string, int]()
+	deps.AddEdge("fmt", "app")
+	deps.AddEdge("errors", "fmt")
+	deps.AddEdge("io", "fmt")
+	deps.AddEdge("errors", "io")
+	fmt.Println(deps.TopologicalSort())
+
+	deps.AddEdge("app", "errors")
+	_, err := deps.TopologicalSort()
+	fmt.Println(errors.Is(err, graph.CycleErr))
+	cycle, _ := errors.FieldOf[[]string](err, graph.CycleKey)
+	fmt.Println(cycle)
+	// Output:
+	// [errors io fmt app] <nil>
+	// true
+	// [fmt app errors fmt]
+}
+
+func ExampleGraph_FindCycle() {
+	g := graph.NewUndirected[int, int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	fmt.Println(g.FindCycle(), g.IsAcyclic())
+	g.AddEdge(3, 1)
+	fmt.Println(g.FindCycle(), g.IsAcyclic())
+	// Output:
+	// None true
+	// Some([1 2 3 1]) false
+}
@@ -0,0 +1,88 @@
+package graph
+
+import "github.com/flowonyx/functional/collections"
+
+// BFS returns the nodes that can be reached from start in breadth-first order, starting with start.
+// It returns nil if start is not in the graph.
+func (g *Graph[N, W]) BFS(start N) []N {
+	var output []N
+	g.BFSUntil(start, func(n N, _ int) bool {
+		output = append(output, n)
+		return false
+	})
+	return output
+}
+
+// BFSUntil applies action to the nodes that can be reached from start in breadth-first order,
+// along with the number of edges between start and the node, until action returns true.
+func (g *Graph[N, W]) BFSUntil(start N, action func(node N, depth int) bool) {
+	if !g.HasNode(start) {
+		return
+	}
+	type visit struct {
+		node  N
+		depth int
+	}
+	seen := map[N]bool{start: true}
+	queue := collections.DequeFrom([]visit{{start, 0}})
+	for !queue.IsEmpty() {
+		v, _ := queue.PopFront()
+		if action(v.node, v.depth) {
+			return
+		}
+		for _, e := range g.out[v.node] {
+			if !seen[e.To] {
+				seen[e.To] = true
+				queue.PushBack(visit{e.To, v.depth + 1})
+			}
+		}
+	}
+}
+
+// DFS returns the nodes that can be reached from start in depth-first order, starting with start.
+// Each node comes before the nodes that were first reached through it.
+// It returns nil if start is not in the graph.
+func (g *Graph[N, W]) DFS(start N) []N {
+	var output []N
+	g.DFSUntil(start, func(n N) bool {
+		output = append(output, n)
+		return false
+	})
+	return output
+}
+
+// DFSUntil applies action to the nodes that can be reached from start in depth-first order until action returns true.
+func (g *Graph[N, W]) DFSUntil(start N, action func(N) bool) {
+	if !g.HasNode(start) {
+		return
+	}
+	seen := make(map[N]bool)
+	stack := collections.DequeFrom([]N{start})
+	for !stack.IsEmpty() {
+		n, _ := stack.PopBack()
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		if action(n) {
+			return
+		}
+		// Push in reverse so the first neighbor is visited first.
+		edges := g.out[n]
+		for i := len(edges) - 1; i >= 0; i-- {
+			if !seen[edges[i].To] {
+				stack.PushBack(edges[i].To)
+			}
+		}
+	}
+}
+
+// IsReachable tests whether there is a path from one node to the other.
+func (g *Graph[N, W]) IsReachable(from, to N) bool {
+	found := false
+	g.BFSUntil(from, func(n N, _ int) bool {
+		found = n == to
+		return found
+	})
+	return found
+}
@@ -0,0 +1,45 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/graph"
+)
+
+func tree() *graph.Graph[string, int] {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("root", "a")
+	g.AddEdge("root", "b")
+	g.AddEdge("a", "a1")
+	g.AddEdge("a", "a2")
+	g.AddEdge("b", "b1")
+	return g
+}
+
+func ExampleGraph_BFS() {
+	fmt.Println(tree().BFS("root"))
+	// Output: [root a b a1 a2 b1]
+}
+
+func ExampleGraph_BFSUntil() {
+	tree().BFSUntil("root", func(n string, depth int) bool {
+		fmt.Println(n, depth)
+		return n == "a1"
+	})
+	// Output:
+	// root 0
+	// a 1
+	// b 1
+	// a1 2
+}
+
+func ExampleGraph_DFS() {
+	g := tree()
+	fmt.Println(g.DFS("root"))
+	fmt.Println(g.DFS("missing"))
+	fmt.Println(g.IsReachable("a", "b1"), g.IsReachable("root", "b1"))
+	// Output:
+	// [root a a1 a2 b b1]
+	// []
+	// false true
+}
@@ -0,0 +1,260 @@
+// Package interval provides an Interval type for ranges of ordered values with open or closed bounds,
+// an IntervalSet that keeps a union of intervals merged, and an interval Tree for finding the intervals
+// that hold a value.
+package interval
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/option"
+	"golang.org/x/exp/constraints"
+)
+
+// Bound tells whether an end of an Interval includes the value at that end.
+type Bound int
+
+const (
+	// Closed bounds include the value at the end.
+	Closed Bound = iota
+	// Open bounds do not include the value at the end.
+	Open
+)
+
+// Interval is the range of values between a lower and an upper end, each of which may or may not be included.
+// Intervals are treated as continuous, so [1, 2] and [3, 4] are not adjacent even for integers.
+// An Interval whose lower end is above its upper end, or where they are equal and either bound is open, is empty.
+type Interval[T constraints.Ordered] struct {
+	lo, hi         T
+	loOpen, hiOpen bool
+}
+
+// New creates an Interval from lo to hi with the given bounds.
+func New[T constraints.Ordered](lo, hi T, loBound, hiBound Bound) Interval[T] {
+	return Interval[T]{lo: lo, hi: hi, loOpen: loBound == Open, hiOpen: hiBound == Open}
+}
+
+// ClosedRange creates the Interval [lo, hi], which includes both ends.
+func ClosedRange[T constraints.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Closed, Closed)
+}
+
+// OpenRange creates the Interval (lo, hi), which includes neither end.
+func OpenRange[T constraints.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Open, Open)
+}
+
+// ClosedOpen creates the Interval [lo, hi), which includes lo but not hi. This is the usual form for time slots.
+func ClosedOpen[T constraints.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Closed, Open)
+}
+
+// OpenClosed creates the Interval (lo, hi], which includes hi but not lo.
+func OpenClosed[T constraints.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Open, Closed)
+}
+
+// Point creates the Interval [value, value], which holds only value.
+func Point[T constraints.Ordered](value T) Interval[T] {
+	return ClosedRange(value, value)
+}
+
+// Empty returns an empty Interval.
+func Empty[T constraints.Ordered]() Interval[T] {
+	var zero T
+	return OpenRange(zero, zero)
+}
+
+// Lo returns the value at the lower end.
+func (i Interval[T]) Lo() T {
+	return i.lo
+}
+
+// Hi returns the value at the upper end.
+func (i Interval[T]) Hi() T {
+	return i.hi
+}
+
+// LoBound returns whether the lower end is included.
+func (i Interval[T]) LoBound() Bound {
+	return boundOf(i.loOpen)
+}
+
+// HiBound returns whether the upper end is included.
+func (i Interval[T]) HiBound() Bound {
+	return boundOf(i.hiOpen)
+}
+
+func boundOf(open bool) Bound {
+	if open {
+		return Open
+	}
+	return Closed
+}
+
+// IsEmpty tests whether the Interval holds no values.
+func (i Interval[T]) IsEmpty() bool {
+	return i.lo > i.hi || (i.lo == i.hi && (i.loOpen || i.hiOpen))
+}
+
+// Equal tests whether two Intervals hold the same values. All empty Intervals are equal.
+func (i Interval[T]) Equal(other Interval[T]) bool {
+	if i.IsEmpty() || other.IsEmpty() {
+		return i.IsEmpty() && other.IsEmpty()
+	}
+	return i == other
+}
+
+// String formats the Interval with brackets for closed bounds and parentheses for open ones, such as [1, 5).
+func (i Interval[T]) String() string {
+	if i.IsEmpty() {
+		return "∅"
+	}
+	left, right := "[", "]"
+	if i.loOpen {
+		left = "("
+	}
+	if i.hiOpen {
+		right = ")"
+	}
+	return fmt.Sprintf("%s%v, %v%s", left, i.lo, i.hi, right)
+}
+
+// compareLo orders lower ends. A closed end comes before an open one at the same value, since it starts earlier.
+func compareLo[T constraints.Ordered](a, b Interval[T]) int {
+	switch {
+	case a.lo < b.lo:
+		return -1
+	case a.lo > b.lo:
+		return 1
+	case a.loOpen == b.loOpen:
+		return 0
+	case a.loOpen:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// compareHi orders upper ends. An open end comes before a closed one at the same value, since it stops earlier.
+func compareHi[T constraints.Ordered](a, b Interval[T]) int {
+	switch {
+	case a.hi < b.hi:
+		return -1
+	case a.hi > b.hi:
+		return 1
+	case a.hiOpen == b.hiOpen:
+		return 0
+	case a.hiOpen:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compare orders Intervals by their lower ends and then by their upper ends.
+func compare[T constraints.Ordered](a, b Interval[T]) int {
+	if c := compareLo(a, b); c != 0 {
+		return c
+	}
+	return compareHi(a, b)
+}
+
+// endsBefore tests whether a stops before b starts, so that they do not share any value.
+func endsBefore[T constraints.Ordered](a, b Interval[T]) bool {
+	return a.hi < b.lo || (a.hi == b.lo && (a.hiOpen || b.loOpen))
+}
+
+// Contains tests whether value is in the Interval.
+func (i Interval[T]) Contains(value T) bool {
+	return i.Overlaps(Point(value))
+}
+
+// ContainsInterval tests whether every value in other is also in the Interval. The empty Interval is in every Interval.
+func (i Interval[T]) ContainsInterval(other Interval[T]) bool {
+	return other.IsEmpty() || (!i.IsEmpty() && compareLo(i, other) <= 0 && compareHi(i, other) >= 0)
+}
+
+// Overlaps tests whether the Intervals share any value.
+func (i Interval[T]) Overlaps(other Interval[T]) bool {
+	return !i.IsEmpty() && !other.IsEmpty() && !endsBefore(i, other) && !endsBefore(other, i)
+}
+
+// Intersect returns the values that are in both Intervals, which is empty if they do not overlap.
+func (i Interval[T]) Intersect(other Interval[T]) Interval[T] {
+	if !i.Overlaps(other) {
+		return Empty[T]()
+	}
+	output := i
+	if compareLo(other, i) > 0 {
+		output.lo, output.loOpen = other.lo, other.loOpen
+	}
+	if compareHi(other, i) < 0 {
+		output.hi, output.hiOpen = other.hi, other.hiOpen
+	}
+	return output
+}
+
+// touches tests whether the Intervals overlap or meet at a value that one of them includes,
+// so that together they form a single Interval.
+func touches[T constraints.Ordered](a, b Interval[T]) bool {
+	return a.Overlaps(b) ||
+		(a.hi == b.lo && !(a.hiOpen && b.loOpen)) ||
+		(b.hi == a.lo && !(b.hiOpen && a.loOpen))
+}
+
+// Span returns the smallest Interval that holds both Intervals, including any gap between them.
+func (i Interval[T]) Span(other Interval[T]) Interval[T] {
+	if i.IsEmpty() {
+		return other
+	}
+	if other.IsEmpty() {
+		return i
+	}
+	output := i
+	if compareLo(other, i) < 0 {
+		output.lo, output.loOpen = other.lo, other.loOpen
+	}
+	if compareHi(other, i) > 0 {
+		output.hi, output.hiOpen = other.hi, other.hiOpen
+	}
+	return output
+}
+
+// Union returns the values in either Interval as a single Interval,
+// or None if there is a gap between them so that they cannot be joined.
+func (i Interval[T]) Union(other Interval[T]) option.Option[Interval[T]] {
+	if i.IsEmpty() || other.IsEmpty() || touches(i, other) {
+		return option.Some(i.Span(other))
+	}
+	return option.None[Interval[T]]()
+}
+
+// Gap returns the values between two Intervals that do not overlap or meet, or None if there is no gap between them.
+func (i Interval[T]) Gap(other Interval[T]) option.Option[Interval[T]] {
+	if i.IsEmpty() || other.IsEmpty() || touches(i, other) {
+		return option.None[Interval[T]]()
+	}
+	first, second := i, other
+	if compareLo(second, first) < 0 {
+		first, second = second, first
+	}
+	return option.Some(Interval[T]{lo: first.hi, loOpen: !first.hiOpen, hi: second.lo, hiOpen: !second.loOpen})
+}
+
+// Difference returns the values in the Interval that are not in other, which can be zero, one or two Intervals.
+func (i Interval[T]) Difference(other Interval[T]) []Interval[T] {
+	if !i.Overlaps(other) {
+		if i.IsEmpty() {
+			return nil
+		}
+		return []Interval[T]{i}
+	}
+	var output []Interval[T]
+	if below := (Interval[T]{lo: i.lo, loOpen: i.loOpen, hi: other.lo, hiOpen: !other.loOpen}); !below.IsEmpty() && compareLo(i, other) < 0 {
+		output = append(output, below)
+	}
+	if above := (Interval[T]{lo: other.hi, loOpen: !other.hiOpen, hi: i.hi, hiOpen: i.hiOpen}); !above.IsEmpty() && compareHi(i, other) > 0 {
+		output = append(output, above)
+	}
+	return output
+}
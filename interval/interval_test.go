@@ -0,0 +1,76 @@
+package interval_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional/interval"
+)
+
+func ExampleInterval() {
+	i := interval.ClosedOpen(1, 5)
+	fmt.Println(i, i.Lo(), i.Hi(), i.HiBound() == interval.Open)
+	fmt.Println(i.Contains(1), i.Contains(5), i.ContainsInterval(interval.ClosedRange(2, 4)))
+	fmt.Println(interval.OpenRange(3, 3).IsEmpty(), interval.Point(3).IsEmpty(), interval.Empty[int]())
+	// Output:
+	// [1, 5) 1 5 true
+	// true false true
+	// true false ∅
+}
+
+func ExampleInterval_Overlaps() {
+	morning := interval.ClosedOpen(9, 12)
+	fmt.Println(morning.Overlaps(interval.ClosedOpen(11, 13)))
+	fmt.Println(morning.Overlaps(interval.ClosedOpen(12, 13)))
+	fmt.Println(morning.Intersect(interval.ClosedRange(11, 15)))
+	fmt.Println(morning.Intersect(interval.ClosedRange(12, 15)))
+	// Output:
+	// true
+	// false
+	// [11, 12)
+	// ∅
+}
+
+func ExampleInterval_Union() {
+	a := interval.ClosedOpen(1, 3)
+	fmt.Println(a.Union(interval.ClosedRange(3, 5)))
+	fmt.Println(a.Union(interval.OpenRange(3, 5)))
+	fmt.Println(a.Gap(interval.OpenRange(3, 5)))
+	fmt.Println(a.Gap(interval.ClosedRange(6, 8)))
+	fmt.Println(a.Span(interval.ClosedRange(6, 8)))
+	fmt.Println(interval.ClosedRange(1, 10).Difference(interval.OpenClosed(3, 5)))
+	// Output:
+	// Some([1, 5])
+	// None
+	// Some([3, 3])
+	// Some([3, 6))
+	// [1, 8]
+	// [[1, 3] (5, 10]]
+}
+
+func TestIntervalEdges(t *testing.T) {
+	tests := []struct {
+		a, b     interval.Interval[float64]
+		overlaps bool
+	}{
+		{interval.ClosedRange(0.0, 1), interval.ClosedRange(1.0, 2), true},
+		{interval.ClosedOpen(0.0, 1), interval.ClosedRange(1.0, 2), false},
+		{interval.ClosedRange(0.0, 1), interval.OpenClosed(1.0, 2), false},
+		{interval.ClosedRange(0.0, 5), interval.Point(2.5), true},
+		{interval.ClosedRange(0.0, 5), interval.Empty[float64](), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Overlaps(tt.b); got != tt.overlaps {
+			t.Errorf("%v.Overlaps(%v) = %v, want %v", tt.a, tt.b, got, tt.overlaps)
+		}
+		if got := tt.b.Overlaps(tt.a); got != tt.overlaps {
+			t.Errorf("%v.Overlaps(%v) = %v, want %v", tt.b, tt.a, got, tt.overlaps)
+		}
+		if got := !tt.a.Intersect(tt.b).IsEmpty(); got != tt.overlaps {
+			t.Errorf("%v.Intersect(%v) = %v", tt.a, tt.b, tt.a.Intersect(tt.b))
+		}
+	}
+	if !interval.ClosedRange(2, 1).Equal(interval.OpenRange(0, 0)) {
+		t.Error("empty Intervals should be equal")
+	}
+}
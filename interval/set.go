@@ -0,0 +1,148 @@
+package interval
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/flowonyx/functional/list"
+	"golang.org/x/exp/constraints"
+)
+
+// IntervalSet is a union of Intervals. It keeps them sorted and merges any that overlap or meet,
+// so each value is in at most one of its Intervals.
+// The zero value is an empty IntervalSet that is ready to use.
+type IntervalSet[T constraints.Ordered] struct {
+	intervals []Interval[T]
+}
+
+// NewSet creates an IntervalSet holding the union of the given Intervals.
+func NewSet[T constraints.Ordered](intervals ...Interval[T]) IntervalSet[T] {
+	var s IntervalSet[T]
+	list.Iter(s.Add, intervals)
+	return s
+}
+
+// Intervals returns the merged Intervals in order.
+func (s IntervalSet[T]) Intervals() []Interval[T] {
+	return slices.Clone(s.intervals)
+}
+
+// Len returns the number of merged Intervals.
+func (s IntervalSet[T]) Len() int {
+	return len(s.intervals)
+}
+
+// IsEmpty tests whether the IntervalSet holds no values.
+func (s IntervalSet[T]) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Equal tests whether two IntervalSets hold the same values.
+func (s IntervalSet[T]) Equal(other IntervalSet[T]) bool {
+	return slices.EqualFunc(s.intervals, other.intervals, Interval[T].Equal)
+}
+
+// String formats the IntervalSet as its Intervals joined by ∪, such as [1, 3) ∪ [5, 8].
+func (s IntervalSet[T]) String() string {
+	if s.IsEmpty() {
+		return "∅"
+	}
+	return strings.Join(list.Map(Interval[T].String, s.intervals), " ∪ ")
+}
+
+// Add adds the values in i, merging it with any Intervals it overlaps or meets.
+func (s *IntervalSet[T]) Add(i Interval[T]) {
+	if i.IsEmpty() {
+		return
+	}
+	// Find the run of Intervals that touch i, which are next to each other because they are sorted.
+	start := 0
+	for start < len(s.intervals) && endsBefore(s.intervals[start], i) && !touches(s.intervals[start], i) {
+		start++
+	}
+	end := start
+	for end < len(s.intervals) && touches(s.intervals[end], i) {
+		i = i.Span(s.intervals[end])
+		end++
+	}
+	s.intervals = slices.Replace(slices.Clone(s.intervals), start, end, i)
+}
+
+// Remove removes the values in i, splitting any Interval that i falls in the middle of.
+func (s *IntervalSet[T]) Remove(i Interval[T]) {
+	s.intervals = list.Collect(func(m Interval[T]) []Interval[T] { return m.Difference(i) }, s.intervals)
+}
+
+// Contains tests whether value is in the IntervalSet.
+func (s IntervalSet[T]) Contains(value T) bool {
+	return s.ContainsInterval(Point(value))
+}
+
+// ContainsInterval tests whether every value in i is in the IntervalSet.
+func (s IntervalSet[T]) ContainsInterval(i Interval[T]) bool {
+	if i.IsEmpty() {
+		return true
+	}
+	// The first Interval that does not end before i starts is the only one that could hold it.
+	n, _ := slices.BinarySearchFunc(s.intervals, i, func(m, target Interval[T]) int {
+		if endsBefore(m, target) {
+			return -1
+		}
+		return 1
+	})
+	return n < len(s.intervals) && s.intervals[n].ContainsInterval(i)
+}
+
+// Overlaps tests whether any value in i is in the IntervalSet.
+func (s IntervalSet[T]) Overlaps(i Interval[T]) bool {
+	return list.Exists(i.Overlaps, s.intervals...)
+}
+
+// Union returns the values that are in either IntervalSet.
+func (s IntervalSet[T]) Union(other IntervalSet[T]) IntervalSet[T] {
+	output := s
+	list.Iter(output.Add, other.intervals)
+	return output
+}
+
+// Intersect returns the values that are in both IntervalSets.
+func (s IntervalSet[T]) Intersect(other IntervalSet[T]) IntervalSet[T] {
+	var output IntervalSet[T]
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(other.intervals) {
+		a, b := s.intervals[i], other.intervals[j]
+		if both := a.Intersect(b); !both.IsEmpty() {
+			output.intervals = append(output.intervals, both)
+		}
+		if compareHi(a, b) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return output
+}
+
+// Difference returns the values that are in the IntervalSet but not in other.
+func (s IntervalSet[T]) Difference(other IntervalSet[T]) IntervalSet[T] {
+	output := s
+	list.Iter(output.Remove, other.intervals)
+	return output
+}
+
+// Gaps returns the Intervals between the merged Intervals, such as the free ranges between allocated ones.
+func (s IntervalSet[T]) Gaps() []Interval[T] {
+	var output []Interval[T]
+	for k := 1; k < len(s.intervals); k++ {
+		output = append(output, s.intervals[k-1].Gap(s.intervals[k]).Value())
+	}
+	return output
+}
+
+// Span returns the smallest Interval that holds every value in the IntervalSet.
+func (s IntervalSet[T]) Span() Interval[T] {
+	if s.IsEmpty() {
+		return Empty[T]()
+	}
+	return s.intervals[0].Span(s.intervals[len(s.intervals)-1])
+}
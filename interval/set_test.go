@@ -0,0 +1,75 @@
+package interval_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/interval"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+)
+
+func ExampleIntervalSet() {
+	s := interval.NewSet(
+		interval.ClosedOpen(1, 3),
+		interval.ClosedRange(5, 8),
+		interval.ClosedOpen(3, 4),
+		interval.ClosedRange(7, 9),
+	)
+	fmt.Println(s, s.Len())
+	fmt.Println(s.Contains(3), s.Contains(4), s.ContainsInterval(interval.ClosedRange(6, 9)))
+	s.Remove(interval.OpenRange(1, 2))
+	fmt.Println(s)
+	fmt.Println(s.Gaps(), s.Span())
+	// Output:
+	// [1, 4) ∪ [5, 9] 2
+	// true false true
+	// [1, 1] ∪ [2, 4) ∪ [5, 9]
+	// [(1, 2) [4, 5)] [1, 9]
+}
+
+func ExampleIntervalSet_Intersect() {
+	booked := interval.NewSet(interval.ClosedOpen(9, 10), interval.ClosedOpen(13, 15))
+	open := interval.NewSet(interval.ClosedOpen(8, 12), interval.ClosedOpen(14, 18))
+	fmt.Println(booked.Intersect(open))
+	fmt.Println(open.Difference(booked))
+	fmt.Println(open.Union(booked))
+	// Output:
+	// [9, 10) ∪ [14, 15)
+	// [8, 9) ∪ [10, 12) ∪ [15, 18)
+	// [8, 12) ∪ [13, 18)
+}
+
+// intervalGen makes half-open Intervals over small integers, which makes overlaps and shared ends likely.
+func intervalGen() prop.Gen[interval.Interval[int]] {
+	return prop.Map(func(p functional.Pair[int, int]) interval.Interval[int] {
+		return interval.ClosedOpen(p.First, p.First+p.Second)
+	}, prop.PairOf(prop.Int(0, 20), prop.Int(0, 5)))
+}
+
+func TestIntervalSetMatchesPoints(t *testing.T) {
+	gen := prop.PairOf(prop.SliceOf(intervalGen()), prop.SliceOf(intervalGen()))
+	prop.ForAll(t, gen, func(p functional.Pair[[]interval.Interval[int], []interval.Interval[int]]) bool {
+		a, b := interval.NewSet(p.First...), interval.NewSet(p.Second...)
+		in := func(intervals []interval.Interval[int], v int) bool {
+			return list.Exists(func(i interval.Interval[int]) bool { return i.Contains(v) }, intervals...)
+		}
+		// The merged Intervals must be sorted with gaps between them.
+		merged := a.Intervals()
+		for k := 1; k < len(merged); k++ {
+			if merged[k-1].Gap(merged[k]).IsNone() {
+				return false
+			}
+		}
+		union, intersect, difference := a.Union(b), a.Intersect(b), a.Difference(b)
+		for v := -1; v <= 26; v++ {
+			inA, inB := in(p.First, v), in(p.Second, v)
+			if a.Contains(v) != inA || union.Contains(v) != (inA || inB) ||
+				intersect.Contains(v) != (inA && inB) || difference.Contains(v) != (inA && !inB) {
+				return false
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,208 @@
+package interval
+
+import "golang.org/x/exp/constraints"
+
+// Entry is an Interval with a value attached, as stored in a Tree.
+type Entry[T constraints.Ordered, V any] struct {
+	Interval Interval[T]
+	Value    V
+}
+
+// treeNode is a node of an AVL tree ordered by interval. Entries with the same interval share a node.
+type treeNode[T constraints.Ordered, V any] struct {
+	interval    Interval[T]
+	values      []V
+	left, right *treeNode[T, V]
+	height      int
+	// last is the interval in this subtree with the highest upper end.
+	last Interval[T]
+}
+
+func height[T constraints.Ordered, V any](n *treeNode[T, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeNode[T, V]) update() *treeNode[T, V] {
+	n.height = 1 + max(height(n.left), height(n.right))
+	n.last = n.interval
+	for _, c := range []*treeNode[T, V]{n.left, n.right} {
+		if c != nil && compareHi(c.last, n.last) > 0 {
+			n.last = c.last
+		}
+	}
+	return n
+}
+
+func (n *treeNode[T, V]) rotateRight() *treeNode[T, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n.update()
+	return l.update()
+}
+
+func (n *treeNode[T, V]) rotateLeft() *treeNode[T, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n.update()
+	return r.update()
+}
+
+func (n *treeNode[T, V]) balance() *treeNode[T, V] {
+	n.update()
+	switch b := height(n.left) - height(n.right); {
+	case b > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case b < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func insert[T constraints.Ordered, V any](n *treeNode[T, V], i Interval[T], value V) *treeNode[T, V] {
+	if n == nil {
+		return (&treeNode[T, V]{interval: i, values: []V{value}}).update()
+	}
+	switch c := compare(i, n.interval); {
+	case c < 0:
+		n.left = insert(n.left, i, value)
+	case c > 0:
+		n.right = insert(n.right, i, value)
+	default:
+		n.values = append(n.values, value)
+		return n
+	}
+	return n.balance()
+}
+
+// remove removes the node holding i and returns the new subtree and the values it held.
+func remove[T constraints.Ordered, V any](n *treeNode[T, V], i Interval[T]) (*treeNode[T, V], []V) {
+	if n == nil {
+		return nil, nil
+	}
+	var removed []V
+	switch c := compare(i, n.interval); {
+	case c < 0:
+		n.left, removed = remove(n.left, i)
+	case c > 0:
+		n.right, removed = remove(n.right, i)
+	default:
+		removed = n.values
+		if n.left == nil {
+			return n.right, removed
+		}
+		if n.right == nil {
+			return n.left, removed
+		}
+		// Replace n with the first node of its right subtree.
+		first := n.right
+		for first.left != nil {
+			first = first.left
+		}
+		n.right, _ = remove(n.right, first.interval)
+		n.interval, n.values = first.interval, first.values
+	}
+	return n.balance(), removed
+}
+
+// Tree holds Intervals with values attached and finds the ones that hold a value or overlap an Interval
+// in logarithmic time plus the number found. It is an AVL tree in which each node knows the highest upper end below it.
+// The zero value is an empty Tree that is ready to use.
+type Tree[T constraints.Ordered, V any] struct {
+	root *treeNode[T, V]
+	size int
+}
+
+// NewTree creates a Tree holding the given entries.
+func NewTree[T constraints.Ordered, V any](entries ...Entry[T, V]) *Tree[T, V] {
+	t := &Tree[T, V]{}
+	for _, e := range entries {
+		t.Insert(e.Interval, e.Value)
+	}
+	return t
+}
+
+// Len returns the number of entries.
+func (t *Tree[T, V]) Len() int {
+	return t.size
+}
+
+// Insert adds i with value attached. Empty Intervals are ignored because no value or Interval can ever overlap them.
+// The same Interval can be inserted more than once with different values.
+func (t *Tree[T, V]) Insert(i Interval[T], value V) {
+	if i.IsEmpty() {
+		return
+	}
+	t.root = insert(t.root, i, value)
+	t.size++
+}
+
+// Remove removes every entry with exactly the Interval i and returns their values in the order they were inserted.
+func (t *Tree[T, V]) Remove(i Interval[T]) []V {
+	var removed []V
+	t.root, removed = remove(t.root, i)
+	t.size -= len(removed)
+	return removed
+}
+
+// Stab returns every entry whose Interval holds value, ordered by Interval.
+func (t *Tree[T, V]) Stab(value T) []Entry[T, V] {
+	return t.Overlapping(Point(value))
+}
+
+// Overlapping returns every entry whose Interval overlaps i, ordered by Interval.
+func (t *Tree[T, V]) Overlapping(i Interval[T]) []Entry[T, V] {
+	var output []Entry[T, V]
+	if i.IsEmpty() {
+		return output
+	}
+	var visit func(n *treeNode[T, V])
+	visit = func(n *treeNode[T, V]) {
+		// Nothing in this subtree reaches the start of i.
+		if n == nil || endsBefore(n.last, i) {
+			return
+		}
+		visit(n.left)
+		// n and everything after it start after i ends.
+		if endsBefore(i, n.interval) {
+			return
+		}
+		if n.interval.Overlaps(i) {
+			output = appendEntries(output, n)
+		}
+		visit(n.right)
+	}
+	visit(t.root)
+	return output
+}
+
+// Entries returns every entry, ordered by Interval.
+func (t *Tree[T, V]) Entries() []Entry[T, V] {
+	var output []Entry[T, V]
+	var visit func(n *treeNode[T, V])
+	visit = func(n *treeNode[T, V]) {
+		if n == nil {
+			return
+		}
+		visit(n.left)
+		output = appendEntries(output, n)
+		visit(n.right)
+	}
+	visit(t.root)
+	return output
+}
+
+func appendEntries[T constraints.Ordered, V any](output []Entry[T, V], n *treeNode[T, V]) []Entry[T, V] {
+	for _, v := range n.values {
+		output = append(output, Entry[T, V]{Interval: n.interval, Value: v})
+	}
+	return output
+}
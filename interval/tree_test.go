@@ -0,0 +1,60 @@
+package interval_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/interval"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+)
+
+func ExampleTree() {
+	meetings := interval.NewTree(
+		interval.Entry[int, string]{Interval: interval.ClosedOpen(900, 1000), Value: "standup"},
+		interval.Entry[int, string]{Interval: interval.ClosedOpen(930, 1100), Value: "review"},
+		interval.Entry[int, string]{Interval: interval.ClosedOpen(1300, 1400), Value: "lunch talk"},
+	)
+	fmt.Println(meetings.Stab(945))
+	fmt.Println(meetings.Stab(1000))
+	fmt.Println(meetings.Overlapping(interval.ClosedRange(1045, 1300)))
+	fmt.Println(meetings.Remove(interval.ClosedOpen(930, 1100)), meetings.Len())
+	// Output:
+	// [{[900, 1000) standup} {[930, 1100) review}]
+	// [{[930, 1100) review}]
+	// [{[930, 1100) review} {[1300, 1400) lunch talk}]
+	// [review] 2
+}
+
+func TestTreeMatchesScan(t *testing.T) {
+	gen := prop.PairOf(prop.SliceOf(intervalGen()), prop.SliceOf(intervalGen()))
+	prop.ForAll(t, gen, func(p functional.Pair[[]interval.Interval[int], []interval.Interval[int]]) bool {
+		tree := interval.NewTree[int, int]()
+		list.Iteri(func(k int, i interval.Interval[int]) { tree.Insert(i, k) }, p.First)
+		removed := 0
+		for _, i := range p.Second[:len(p.Second)/2] {
+			removed += len(tree.Remove(i))
+		}
+		kept := list.Filter(func(i interval.Interval[int]) bool {
+			return !list.Exists(i.Equal, p.Second[:len(p.Second)/2]...) && !i.IsEmpty()
+		}, p.First...)
+		if tree.Len() != len(kept) || len(tree.Entries()) != len(kept) {
+			return false
+		}
+		for _, q := range p.Second {
+			want := list.Filter(q.Overlaps, kept...)
+			got := list.Map(func(e interval.Entry[int, int]) interval.Interval[int] { return e.Interval }, tree.Overlapping(q))
+			if len(got) != len(want) {
+				return false
+			}
+		}
+		for v := -1; v <= 26; v++ {
+			want := list.Filter(func(i interval.Interval[int]) bool { return i.Contains(v) }, kept...)
+			if len(tree.Stab(v)) != len(want) {
+				return false
+			}
+		}
+		return true
+	})
+}
@@ -8,6 +8,13 @@ type numeric interface {
 	constraints.Float | constraints.Integer
 }
 
+// Number is implemented by numeric types that use methods instead of operators, such as decimal.Decimal.
+// The zero value of a Number must be 0.
+type Number[T any] interface {
+	Add(T) T
+	DivInt(int) T
+}
+
 // Average calculates the average of all provided values.
 func Average[T numeric](values ...T) T {
 	if len(values) == 0 {
@@ -25,3 +32,20 @@ func AverageBy[T any, R numeric](projection func(T) R, values ...T) R {
 	r := Map(projection, values)
 	return Average(r...)
 }
+
+// AverageNumbers calculates the average of all provided values using their Add and DivInt methods.
+// It is the equivalent of Average for types such as decimal.Decimal.
+func AverageNumbers[T Number[T]](values ...T) T {
+	if len(values) == 0 {
+		panic("AverageNumbers cannot operate on empty list of values")
+	}
+	return SumNumbers(values).DivInt(len(values))
+}
+
+// AverageNumbersBy applies projection to each value to get the Number to be used in the average calculation.
+func AverageNumbersBy[T any, R Number[R]](projection func(T) R, values ...T) R {
+	if len(values) == 0 {
+		panic("AverageNumbersBy cannot operate on empty list of values")
+	}
+	return SumNumbersBy(projection, values).DivInt(len(values))
+}
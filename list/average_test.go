@@ -3,6 +3,7 @@ package list_test
 import (
 	"fmt"
 
+	"github.com/flowonyx/functional/decimal"
 	. "github.com/flowonyx/functional/list"
 )
 
@@ -17,3 +18,8 @@ func ExampleAverageBy() {
 	}, []uint{1, 2, 3, 4, 5}...))
 	// Output: 1.5
 }
+
+func ExampleAverageNumbers() {
+	fmt.Println(AverageNumbers(decimal.MustParse("1.10"), decimal.MustParse("2.20"), decimal.MustParse("4.30")).Round(2))
+	// Output: 2.53
+}
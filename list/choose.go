@@ -1,8 +1,6 @@
 package list
 
 import (
-	"fmt"
-
 	. "github.com/flowonyx/functional"
 	"github.com/flowonyx/functional/errors"
 	"github.com/flowonyx/functional/option"
@@ -30,7 +28,7 @@ func Pick[T any, R any](chooser func(T) option.Option[R], values []T) (R, error)
 		return false
 	}, values)
 
-	return val, IfV[error](found, nil).Else(fmt.Errorf("Pick(%v): %w", values, errors.NotFoundErr))
+	return val, IfV[error](found, nil).Else(errors.NotFound("Pick"))
 }
 
 // TryPick is the same as Pick but returns None in place of an error.
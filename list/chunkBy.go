@@ -2,6 +2,7 @@ package list
 
 // ChunkBySize accepts a slice of values and returns a two dimensional slice
 // where each inner slice has the length of chunkSize or smaller if at end of values.
+// If values is empty, it returns an empty slice.
 func ChunkBySize[T any](chunkSize int, values []T) [][]T {
 	output := Empty[[]T](len(values)/chunkSize + 1)
 	temp := Empty[T](chunkSize)
@@ -14,6 +15,8 @@ func ChunkBySize[T any](chunkSize int, values []T) [][]T {
 		temp = append(temp, t)
 	}, values)
 
-	output = append(output, temp)
+	if len(temp) > 0 {
+		output = append(output, temp)
+	}
 	return output
 }
@@ -11,3 +11,8 @@ func ExampleChunkBySize() {
 	fmt.Println(chunks)
 	// Output: [[1 2] [3 4] [5 6] [7 8] [9]]
 }
+
+func ExampleChunkBySize_empty() {
+	fmt.Println(list.ChunkBySize(2, []int{}), len(list.ChunkBySize(2, []int{1, 2})))
+	// Output: [] 1
+}
@@ -0,0 +1,92 @@
+package list
+
+import . "github.com/flowonyx/functional"
+
+// Combinations returns a lazy sequence of every way to choose k items from values, ignoring order.
+// Each combination keeps the items in the order they have in values, and the combinations come in
+// lexicographic order of the positions chosen. Items at different positions are treated as different
+// even if they are equal. Each combination is a new slice that can be kept.
+// If k is negative or larger than the number of values, the sequence is empty.
+func Combinations[T any](k int, values []T) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(values)
+		if k < 0 || k > n {
+			return
+		}
+		indexes := InitSlice(k, func(i int) int { return i })
+		for {
+			if !yield(Map(func(i int) T { return values[i] }, indexes)) {
+				return
+			}
+			// Find the rightmost index that can move right and reset the ones after it.
+			i := k - 1
+			for i >= 0 && indexes[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
+
+// Permutations returns a lazy sequence of every ordering of values, in lexicographic order of the positions of the items.
+// Items at different positions are treated as different even if they are equal. Each permutation is a new slice that can be kept.
+func Permutations[T any](values []T) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(values)
+		indexes := InitSlice(n, func(i int) int { return i })
+		for {
+			if !yield(Map(func(i int) T { return values[i] }, indexes)) {
+				return
+			}
+			// Move to the next permutation of indexes in lexicographic order.
+			i := n - 2
+			for i >= 0 && indexes[i] > indexes[i+1] {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			j := n - 1
+			for indexes[j] < indexes[i] {
+				j--
+			}
+			indexes[i], indexes[j] = indexes[j], indexes[i]
+			for l, r := i+1, n-1; l < r; l, r = l+1, r-1 {
+				indexes[l], indexes[r] = indexes[r], indexes[l]
+			}
+		}
+	}
+}
+
+// CartesianProduct returns a lazy sequence of every way to take one item from each of the slices,
+// with the items in the order of the slices. The last slice changes fastest.
+// Each tuple is a new slice that can be kept. If any slice is empty, so is the sequence.
+// Use AllPairs for two slices of different types.
+func CartesianProduct[T any](values ...[]T) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if Exists(func(v []T) bool { return len(v) == 0 }, values...) {
+			return
+		}
+		indexes := make([]int, len(values))
+		for {
+			if !yield(Mapi(func(i int, v []T) T { return v[indexes[i]] }, values)) {
+				return
+			}
+			i := len(values) - 1
+			for i >= 0 && indexes[i] == len(values[i])-1 {
+				indexes[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package list_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+)
+
+func ExampleCombinations() {
+	fmt.Println(list.Combinations(2, []string{"a", "b", "c", "d"}).ToSlice())
+	fmt.Println(len(list.Combinations(0, []int{1, 2}).ToSlice()), len(list.Combinations(3, []int{1, 2}).ToSlice()))
+	// Output:
+	// [[a b] [a c] [a d] [b c] [b d] [c d]]
+	// 1 0
+}
+
+func ExamplePermutations() {
+	fmt.Println(list.Permutations([]int{1, 2, 3}).ToSlice())
+	fmt.Println(list.Permutations([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}).Take(2).ToSlice())
+	// Output:
+	// [[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]
+	// [[1 2 3 4 5 6 7 8 9 10] [1 2 3 4 5 6 7 8 10 9]]
+}
+
+func ExampleCartesianProduct() {
+	fmt.Println(list.CartesianProduct([]string{"a", "b"}, []string{"x", "y", "z"}).ToSlice())
+	fmt.Println(len(list.CartesianProduct([]int{1, 2}, nil).ToSlice()))
+	// Output:
+	// [[a x] [a y] [a z] [b x] [b y] [b z]]
+	// 0
+}
+
+func binomial(n, k int) int {
+	r := 1
+	for i := 1; i <= k; i++ {
+		r = r * (n - k + i) / i
+	}
+	return r
+}
+
+func TestCombinationsCount(t *testing.T) {
+	prop.ForAll(t, prop.PairOf(prop.Int(0, 8), prop.Int(-1, 9)), func(p functional.Pair[int, int]) bool {
+		n, k := p.First, p.Second
+		combinations := list.Combinations(k, list.InitSlice(n, func(i int) int { return i })).ToSlice()
+		if k < 0 || k > n {
+			return len(combinations) == 0
+		}
+		return len(combinations) == binomial(n, k) &&
+			len(list.DistinctBy(func(c []int) string { return fmt.Sprint(c) }, combinations...)) == len(combinations)
+	})
+}
+
+func TestPermutationsCount(t *testing.T) {
+	prop.ForAll(t, prop.Int(0, 6), func(n int) bool {
+		permutations := list.Permutations(list.InitSlice(n, func(i int) int { return i })).ToSlice()
+		factorial := 1
+		for i := 2; i <= n; i++ {
+			factorial *= i
+		}
+		return len(permutations) == factorial &&
+			len(list.DistinctBy(func(c []int) string { return fmt.Sprint(c) }, permutations...)) == factorial
+	})
+}
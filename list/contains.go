@@ -1,6 +1,13 @@
 package list
 
+import "github.com/flowonyx/functional/eq"
+
 // Contains tests whether search is within values.
 func Contains[T comparable](search T, values ...T) bool {
 	return IndexOf(search, values) >= 0
 }
+
+// ContainsWith tests whether search is within values, using equaler to compare them.
+func ContainsWith[T any](equaler eq.Equaler[T], search T, values ...T) bool {
+	return Exists(func(t T) bool { return equaler.Equal(search, t) }, values...)
+}
@@ -1,6 +1,10 @@
 package list
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/eq"
+)
 
 func ExampleContains() {
 	input := []int{1, 2, 3, 4, 5, 6}
@@ -9,3 +13,9 @@ func ExampleContains() {
 	fmt.Println(r1, r2)
 	// Output: true false
 }
+
+func ExampleContainsWith() {
+	input := []string{"Go", "Rust", "Zig"}
+	fmt.Println(ContainsWith(eq.Folded[string](), "rust", input...), Contains("rust", input...))
+	// Output: true false
+}
@@ -1,6 +1,7 @@
 package list
 
 import (
+	"github.com/flowonyx/functional/eq"
 	"golang.org/x/exp/slices"
 )
 
@@ -9,7 +10,7 @@ func Distinct[T comparable](values ...T) []T {
 	output := make([]T, len(values))
 	i := 0
 	Iter(func(t T) {
-		if !slices.Contains(output, t) {
+		if !slices.Contains(output[:i], t) {
 			output[i] = t
 			i++
 		}
@@ -32,3 +33,10 @@ func DistinctBy[T any, Key comparable](projection func(T) Key, values ...T) []T
 	}, values)
 	return slices.Clip(output[0:i])
 }
+
+// DistinctWith returns the values without repitition, using hasher to find the values that are equal.
+// The first of each set of equal values is kept.
+func DistinctWith[T any](hasher eq.Hasher[T], values ...T) []T {
+	seen := newHashIndex[T](hasher)
+	return Filter(func(t T) bool { return seen.add(t, 1) == 1 }, values...)
+}
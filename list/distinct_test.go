@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/flowonyx/functional/eq"
 	"github.com/flowonyx/functional/list"
 )
 
@@ -13,8 +14,20 @@ func ExampleDistinct() {
 	// Output: [1 2 3 4]
 }
 
+func ExampleDistinct_zero() {
+	d := list.Distinct(1, 0, 2, 0, 1)
+	fmt.Println(d)
+	// Output: [1 0 2]
+}
+
 func ExampleDistinctBy() {
 	d := list.DistinctBy(func(i int) string { return "s:" + strconv.Itoa(i) }, 1, 2, 3, 2, 3, 4)
 	fmt.Println(d)
 	// Output: [1 2 3 4]
 }
+
+func ExampleDistinctWith() {
+	d := list.DistinctWith(eq.Folded[string](), "Go", "go", "Zig", "GO", "zig", "Rust")
+	fmt.Println(d)
+	// Output: [Go Zig Rust]
+}
@@ -1,5 +1,7 @@
 package list
 
+import "github.com/flowonyx/functional/eq"
+
 // Equal tests the slices s1 and s2 for equality.
 // They are considered equal only if they contain all the same items in the same order.
 func Equal[T comparable](s1 []T, s2 []T) bool {
@@ -25,3 +27,22 @@ func EqualUnordered[T comparable](s1 []T, s2 []T) bool {
 
 	return equal && indexCount == len(s1)
 }
+
+// EqualWith tests the slices s1 and s2 for equality, using equaler to compare the items.
+// They are considered equal only if they contain all the same items in the same order.
+func EqualWith[T any](equaler eq.Equaler[T], s1 []T, s2 []T) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	return ForAll2(equaler.Equal, s1, s2)
+}
+
+// EqualUnorderedWith tests the slices s1 and s2 for equality, using hasher to compare the items.
+// They are considered equal only if they contain all the same items, each repeated the same number of times, but the order does not matter.
+func EqualUnorderedWith[T any](hasher eq.Hasher[T], s1 []T, s2 []T) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	counts := newHashIndex(hasher, s1...)
+	return ForAll(func(item T) bool { return counts.add(item, -1) >= 0 }, s2)
+}
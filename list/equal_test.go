@@ -1,6 +1,10 @@
 package list
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/eq"
+)
 
 func ExampleEqual() {
 	s1 := []int{1, 2, 3, 4, 5}
@@ -21,3 +25,15 @@ func ExampleEqualUnordered() {
 	fmt.Println(EqualUnordered(s1, s2), EqualUnordered(s1, s3), EqualUnordered(s1, s4))
 	// Output: true false true
 }
+
+func ExampleEqualWith() {
+	s1 := [][]int{{1, 2}, {3}}
+	s2 := [][]int{{1, 2}, {3}}
+	s3 := [][]int{{3}, {1, 2}}
+	slices := eq.Slice(eq.Integer[int]())
+	fmt.Println(EqualWith(slices, s1, s2), EqualWith(slices, s1, s3))
+	fmt.Println(EqualUnorderedWith(slices, s1, s3), EqualUnorderedWith(slices, [][]int{{1}, {1}, {2}}, [][]int{{1}, {2}, {2}}))
+	// Output:
+	// true false
+	// true false
+}
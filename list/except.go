@@ -1,6 +1,9 @@
 package list
 
-import "golang.org/x/exp/slices"
+import (
+	"github.com/flowonyx/functional/eq"
+	"golang.org/x/exp/slices"
+)
 
 // Except returns values that are not in itemsToExclude.
 func Except[T comparable](itemsToExclude []T, values ...T) []T {
@@ -8,3 +11,11 @@ func Except[T comparable](itemsToExclude []T, values ...T) []T {
 		return !slices.Contains(itemsToExclude, t)
 	}, values...)
 }
+
+// ExceptWith returns values that are not in itemsToExclude, using hasher to compare them.
+func ExceptWith[T any](hasher eq.Hasher[T], itemsToExclude []T, values ...T) []T {
+	excluded := newHashIndex(hasher, itemsToExclude...)
+	return Filter(func(t T) bool {
+		return excluded.count(t) == 0
+	}, values...)
+}
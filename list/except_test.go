@@ -3,6 +3,7 @@ package list_test
 import (
 	"fmt"
 
+	"github.com/flowonyx/functional/eq"
 	"github.com/flowonyx/functional/list"
 )
 
@@ -13,3 +14,11 @@ func ExampleExcept() {
 	fmt.Println(e)
 	// Output: [2 4]
 }
+
+func ExampleExceptWith() {
+	original := [][]string{{"a"}, {"a", "b"}, {"c"}}
+	itemsToExclude := [][]string{{"a", "b"}}
+	e := list.ExceptWith(eq.Slice(eq.String[string]()), itemsToExclude, original...)
+	fmt.Println(e)
+	// Output: [[a] [c]]
+}
@@ -28,6 +28,9 @@ func FindBack[T any](predicate func(T) bool, input ...T) (T, error) {
 }
 
 func findFunc[T any](predicate func(T) bool, input []T, start, end int) (T, error) {
+	if len(input) == 0 {
+		return *(new(T)), errors.NotFoundErr
+	}
 	index := -1
 
 	DoRangeUntil(func(i int) bool {
@@ -51,3 +51,10 @@ func ExampleTryFindBack() {
 	fmt.Println(r.Value())
 	// Output: 4
 }
+
+func ExampleFind_empty() {
+	_, err := list.Find(mod2)
+	_, errBack := list.FindBack(mod2)
+	fmt.Println(errors.Is(err, errors.NotFoundErr), errors.Is(errBack, errors.NotFoundErr), list.TryFind(mod2))
+	// Output: true true None
+}
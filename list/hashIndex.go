@@ -0,0 +1,43 @@
+package list
+
+import (
+	. "github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/eq"
+)
+
+// hashIndex finds values that are equal by a Hasher, keeping a count of each.
+type hashIndex[T any] struct {
+	hasher  eq.Hasher[T]
+	buckets map[uint64][]Pair[T, int]
+}
+
+func newHashIndex[T any](hasher eq.Hasher[T], values ...T) hashIndex[T] {
+	index := hashIndex[T]{hasher: hasher, buckets: map[uint64][]Pair[T, int]{}}
+	for _, v := range values {
+		index.add(v, 1)
+	}
+	return index
+}
+
+// add adds n to the count of value and returns the new count.
+func (index hashIndex[T]) add(value T, n int) int {
+	h := index.hasher.Hash(value)
+	bucket := index.buckets[h]
+	for i := range bucket {
+		if index.hasher.Equal(bucket[i].First, value) {
+			bucket[i].Second += n
+			return bucket[i].Second
+		}
+	}
+	index.buckets[h] = append(bucket, PairOf(value, n))
+	return n
+}
+
+func (index hashIndex[T]) count(value T) int {
+	for _, p := range index.buckets[index.hasher.Hash(value)] {
+		if index.hasher.Equal(p.First, value) {
+			return p.Second
+		}
+	}
+	return 0
+}
@@ -1,8 +1,6 @@
 package list
 
 import (
-	"fmt"
-
 	"github.com/flowonyx/functional"
 	"github.com/flowonyx/functional/errors"
 	"github.com/flowonyx/functional/option"
@@ -15,7 +13,7 @@ func Head[T any](values []T) (T, error) {
 	if len(values) > 0 {
 		return values[0], nil
 	}
-	return *(new(T)), fmt.Errorf("%w: Head([0]%T)", errors.IndexOutOfRangeErr, values)
+	return *(new(T)), errors.IndexOutOfRange("Head", 0, 0)
 }
 
 // Tail returns all but the first item from values.
@@ -34,7 +32,7 @@ func Last[T any](values []T) (T, error) {
 	if len(values) > 0 {
 		return values[len(values)-1], nil
 	}
-	return *(new(T)), fmt.Errorf("%w: Last([0]%T)", errors.IndexOutOfRangeErr, values)
+	return *(new(T)), errors.IndexOutOfRange("Last", -1, 0)
 }
 
 // TryHead returns the first item from values as an Option.
@@ -1,16 +1,12 @@
 package list
 
-import (
-	"fmt"
-
-	"github.com/flowonyx/functional/errors"
-)
+import "github.com/flowonyx/functional/errors"
 
 // InsertAt inserts newValue into existing at the given index.
 // If the index is not in the range of indexes for values, it will return a nil slice and a IndexOutOfRangeErr.
 func InsertAt[T any](index int, newValue T, existing []T) ([]T, error) {
 	if index < 0 || index > len(existing) {
-		return nil, fmt.Errorf("%w: InsertAt(%d, _, [%d]%T)", errors.IndexOutOfRangeErr, index, len(existing), existing)
+		return nil, errors.IndexOutOfRange("InsertAt", index, len(existing))
 	}
 	return InsertManyAt(index, []T{newValue}, existing)
 }
@@ -19,7 +15,7 @@ func InsertAt[T any](index int, newValue T, existing []T) ([]T, error) {
 // If the index is not in the range of indexes for existing, it will return a nil slice and a IndexOutOfRangeErr.
 func InsertManyAt[T any](index int, newValues []T, existing []T) ([]T, error) {
 	if index < 0 || index > len(existing) {
-		return nil, fmt.Errorf("%w: InsertManyAt(%d, _, [%d]%T)", errors.IndexOutOfRangeErr, index, len(existing), existing)
+		return nil, errors.IndexOutOfRange("InsertManyAt", index, len(existing))
 	}
 	if index == len(existing) {
 		return append(existing, newValues...), nil
@@ -31,7 +27,7 @@ func InsertManyAt[T any](index int, newValues []T, existing []T) ([]T, error) {
 // If index is not in the range of indexes for values, it will return a nil slice and a IndexOutOfRangeErr.
 func RemoveAt[T any](index int, values []T) ([]T, error) {
 	if index < 0 || index >= len(values) {
-		return nil, fmt.Errorf("%w: RemoveAt(%d, [%d]%T)", errors.IndexOutOfRangeErr, index, len(values), values)
+		return nil, errors.IndexOutOfRange("RemoveAt", index, len(values))
 	}
 	return RemoveManyAt(index, 1, values)
 }
@@ -41,7 +37,7 @@ func RemoveAt[T any](index int, values []T) ([]T, error) {
 // If count is larger the the number of items in values starting at index, it will only remove as many items as is in the slice.
 func RemoveManyAt[T any](index int, count int, values []T) ([]T, error) {
 	if index < 0 || index >= len(values) {
-		return nil, fmt.Errorf("%w: RemoveManyAt(%d, [%d]%T)", errors.IndexOutOfRangeErr, index, len(values), values)
+		return nil, errors.IndexOutOfRange("RemoveManyAt", index, len(values))
 	}
 	count, _ = Min(count, len(values)-index)
 	return append(values[0:index], values[index+count:]...), nil
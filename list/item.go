@@ -1,8 +1,6 @@
 package list
 
 import (
-	"fmt"
-
 	"github.com/flowonyx/functional/errors"
 	"github.com/flowonyx/functional/option"
 )
@@ -10,8 +8,8 @@ import (
 // Item is the same as values[index] but instead of a panic
 // it returns a IndexOutOfRangeErr if index is outside of the range of indexes in values.
 func Item[T any](index int, values []T) (T, error) {
-	if index >= len(values) {
-		return *(new(T)), fmt.Errorf("%w: Item(%d, [%d]%T)", errors.IndexOutOfRangeErr, index, len(values), values)
+	if index < 0 || index >= len(values) {
+		return *(new(T)), errors.IndexOutOfRange("Item", index, len(values))
 	}
 	return values[index], nil
 }
@@ -29,11 +27,11 @@ func TryItem[T any](index int, values []T) option.Option[T] {
 // Item2D is the same as values[index1][index2] but instead of a panic
 // it returns a IndexOutOfRangeErr if index is outside of the range of indexes in values.
 func Item2D[T any](values [][]T, index1, index2 int) (T, error) {
-	if index1 >= len(values) {
-		return *(new(T)), fmt.Errorf("%w: Item2D(%d, _, [%d][_]%T)", errors.IndexOutOfRangeErr, index1, len(values), values)
+	if index1 < 0 || index1 >= len(values) {
+		return *(new(T)), outOfRange("Item2D", 1, index1, len(values))
 	}
-	if index2 >= len(values[index1]) {
-		return *(new(T)), fmt.Errorf("%w: Item2D(%d, %d, [_][%d]%T)", errors.IndexOutOfRangeErr, index1, index2, len(values[index1]), values)
+	if index2 < 0 || index2 >= len(values[index1]) {
+		return *(new(T)), outOfRange("Item2D", 2, index2, len(values[index1]))
 	}
 	return values[index1][index2], nil
 }
@@ -51,14 +49,14 @@ func TryItem2D[T any](values [][]T, index1, index2 int) option.Option[T] {
 // Item3D is the same as values[index1][index2][index3] but instead of a panic
 // it returns a IndexOutOfRangeErr if index is outside of the range of indexes in values.
 func Item3D[T any](values [][][]T, index1, index2, index3 int) (T, error) {
-	if index1 >= len(values) {
-		return *(new(T)), fmt.Errorf("%w: Item3D(%d, _, _, [%d][_][_]%T)", errors.IndexOutOfRangeErr, index1, len(values), values)
+	if index1 < 0 || index1 >= len(values) {
+		return *(new(T)), outOfRange("Item3D", 1, index1, len(values))
 	}
-	if index2 >= len(values[index1]) {
-		return *(new(T)), fmt.Errorf("%w: Item3D(%d, %d, _, [_][%d][_]%T)", errors.IndexOutOfRangeErr, index1, index2, len(values[index1]), values)
+	if index2 < 0 || index2 >= len(values[index1]) {
+		return *(new(T)), outOfRange("Item3D", 2, index2, len(values[index1]))
 	}
-	if index3 >= len(values[index1][index2]) {
-		return *(new(T)), fmt.Errorf("%w: Item3D(%d, %d, %d, [_][_][%d]%T)", errors.IndexOutOfRangeErr, index1, index2, index3, len(values[index1][index2]), values)
+	if index3 < 0 || index3 >= len(values[index1][index2]) {
+		return *(new(T)), outOfRange("Item3D", 3, index3, len(values[index1][index2]))
 	}
 	return values[index1][index2][index3], nil
 }
@@ -76,17 +74,17 @@ func TryItem3D[T any](values [][][]T, index1, index2, index3 int) option.Option[
 // Item4D is the same as values[index1][index2][index3][index4] but instead of a panic
 // it returns a IndexOutOfRangeErr if index is outside of the range of indexes in values.
 func Item4D[T any](values [][][][]T, index1, index2, index3, index4 int) (T, error) {
-	if index1 >= len(values) {
-		return *(new(T)), fmt.Errorf("%w: Item4D(%d, _, _, _, [%d][_][_][_]%T)", errors.IndexOutOfRangeErr, index1, len(values), values)
+	if index1 < 0 || index1 >= len(values) {
+		return *(new(T)), outOfRange("Item4D", 1, index1, len(values))
 	}
-	if index2 >= len(values[index1]) {
-		return *(new(T)), fmt.Errorf("%w: Item4D(%d, %d, _, _, [_][%d][_][_]%T)", errors.IndexOutOfRangeErr, index1, index2, len(values[index1]), values)
+	if index2 < 0 || index2 >= len(values[index1]) {
+		return *(new(T)), outOfRange("Item4D", 2, index2, len(values[index1]))
 	}
-	if index3 >= len(values[index1][index2]) {
-		return *(new(T)), fmt.Errorf("%w: Item4D(%d, %d, %d, _, [_][_][%d][_]%T)", errors.IndexOutOfRangeErr, index1, index2, index3, len(values[index1][index2]), values)
+	if index3 < 0 || index3 >= len(values[index1][index2]) {
+		return *(new(T)), outOfRange("Item4D", 3, index3, len(values[index1][index2]))
 	}
-	if index4 >= len(values[index1][index2][index3]) {
-		return *(new(T)), fmt.Errorf("%w: Item4D(%d, %d, %d, %d, [_][_][_][%d]%T)", errors.IndexOutOfRangeErr, index1, index2, index3, index4, len(values[index1][index2][index3]), values)
+	if index4 < 0 || index4 >= len(values[index1][index2][index3]) {
+		return *(new(T)), outOfRange("Item4D", 4, index4, len(values[index1][index2][index3]))
 	}
 	return Item3D(values[index1], index2, index3, index4)
 }
@@ -100,3 +98,8 @@ func TryItem4D[T any](values [][][][]T, index1, index2, index3, index4 int) opti
 		return option.Some(item)
 	}
 }
+
+// outOfRange returns an IndexOutOfRange error for the index of the given dimension of a multi-dimensional slice.
+func outOfRange(op string, dimension, index, length int) error {
+	return errors.IndexOutOfRange(op, index, length).With("dimension", dimension)
+}
@@ -59,3 +59,13 @@ func ExampleTryItem_second() {
 	fmt.Println(r.IsNone())
 	// Output: true
 }
+
+func ExampleItem_outOfRange() {
+	_, err := list.Item(-1, []int{0, 1})
+	fmt.Println(err)
+	_, err = list.Item2D([][]int{{0, 1}, {2}}, 1, 1)
+	fmt.Println(err)
+	// Output:
+	// index out of range: Item(index=-1, length=2)
+	// index out of range: Item2D(index=1, length=1, dimension=2)
+}
@@ -9,35 +9,45 @@ func Iter[T any](action func(T), values []T) {
 
 // IterRev iterates over the values in reverse, applying action to each value.
 func IterRev[T any](action func(T), values []T) {
-	DoRangeToRev(func(i int) { action(values[i]) }, LastIndexOf(values))
+	for i := LastIndexOf(values); i >= 0; i-- {
+		action(values[i])
+	}
 }
 
 // Iter2 iterates over two slices of values, applying action to each pair of values.
 // It only iterates until the end of the shortest of the value slices.
 func Iter2[T, T2 any](action func(T, T2), values1 []T, values2 []T2) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2))
-	DoRangeTo(func(i int) { action(values1[i], values2[i]) }, min)
+	n := min(len(values1), len(values2))
+	for i := 0; i < n; i++ {
+		action(values1[i], values2[i])
+	}
 }
 
 // Iter2Rev iterates over two slices of values in reverse, applying action to each pair of values.
 // It only iterates until the end of the shortest of the value slices.
 func Iter2Rev[T, T2 any](action func(T, T2), values1 []T, values2 []T2) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2))
-	DoRangeToRev(func(i int) { action(values1[i], values2[i]) }, min)
+	n := min(len(values1), len(values2))
+	for i := n - 1; i >= 0; i-- {
+		action(values1[i], values2[i])
+	}
 }
 
 // Iter3 iterates over three slices of values, applying action to each series of values.
 // It only iterates until the end of the shortest of the value slices.
 func Iter3[T, T2, T3 any](action func(T, T2, T3), values1 []T, values2 []T2, values3 []T3) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2), LastIndexOf(values3))
-	DoRangeTo(func(i int) { action(values1[i], values2[i], values3[i]) }, min)
+	n := min(len(values1), len(values2), len(values3))
+	for i := 0; i < n; i++ {
+		action(values1[i], values2[i], values3[i])
+	}
 }
 
 // Iter3Rev iterates over three slices of values in reverse, applying action to each series of values.
 // It only iterates until the end of the shortest of the value slices.
 func Iter3Rev[T, T2, T3 any](action func(T, T2, T3), values1 []T, values2 []T2, values3 []T3) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2), LastIndexOf(values3))
-	DoRangeToRev(func(i int) { action(values1[i], values2[i], values3[i]) }, min)
+	n := min(len(values1), len(values2), len(values3))
+	for i := n - 1; i >= 0; i-- {
+		action(values1[i], values2[i], values3[i])
+	}
 }
 
 // Iteri iterates over the values, applying action to each value with the index of the value.
@@ -49,35 +59,45 @@ func Iteri[T any](action func(int, T), values []T) {
 
 // IteriRev iterates over the values in reverse, applying action to each value with the index of the value.
 func IteriRev[T any](action func(int, T), values []T) {
-	DoRangeToRev(func(i int) { action(i, values[i]) }, LastIndexOf(values))
+	for i := LastIndexOf(values); i >= 0; i-- {
+		action(i, values[i])
+	}
 }
 
 // Iteri2 iterates over the two slices of values, applying action to each pair of values with the index of the values.
 // It only iterates until the end of the shortest of the value slices.
 func Iteri2[T, T2 any](action func(int, T, T2), values1 []T, values2 []T2) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2))
-	DoRangeTo(func(i int) { action(i, values1[i], values2[i]) }, min)
+	n := min(len(values1), len(values2))
+	for i := 0; i < n; i++ {
+		action(i, values1[i], values2[i])
+	}
 }
 
 // Iteri2Rev iterates over the two slices of values in reverse, applying action to each pair of values with the index of the values.
 // It only iterates until the end of the shortest of the value slices.
 func Iteri2Rev[T, T2 any](action func(int, T, T2), values1 []T, values2 []T2) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2))
-	DoRangeToRev(func(i int) { action(i, values1[i], values2[i]) }, min)
+	n := min(len(values1), len(values2))
+	for i := n - 1; i >= 0; i-- {
+		action(i, values1[i], values2[i])
+	}
 }
 
 // Iteri3 iterates over the three slices of values, applying action to each series of values with the index of the values.
 // It only iterates until the end of the shortest of the value slices.
 func Iteri3[T, T2, T3 any](action func(int, T, T2, T3), values1 []T, values2 []T2, values3 []T3) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2), LastIndexOf(values3))
-	DoRangeTo(func(i int) { action(i, values1[i], values2[i], values3[i]) }, min)
+	n := min(len(values1), len(values2), len(values3))
+	for i := 0; i < n; i++ {
+		action(i, values1[i], values2[i], values3[i])
+	}
 }
 
 // Iteri3Rev iterates over the three slices of values in reverse, applying action to each series of values with the index of the values.
 // It only iterates until the end of the shortest of the value slices.
 func Iteri3Rev[T, T2, T3 any](action func(int, T, T2, T3), values1 []T, values2 []T2, values3 []T3) {
-	min, _ := Min(LastIndexOf(values1), LastIndexOf(values2), LastIndexOf(values3))
-	DoRangeToRev(func(i int) { action(i, values1[i], values2[i], values3[i]) }, min)
+	n := min(len(values1), len(values2), len(values3))
+	for i := n - 1; i >= 0; i-- {
+		action(i, values1[i], values2[i], values3[i])
+	}
 }
 
 // Iter2D iterates over a two dimensional slice of values, applying action to each value.
@@ -111,3 +111,20 @@ func ExampleIteriRev() {
 	fmt.Println(s)
 	// Output: 4:4,3:3,2:2,1:1,0:0,
 }
+
+func ExampleIter2_empty() {
+	calls := 0
+	count := func(...any) { calls++ }
+	list.IterRev(func(int) { count() }, []int{})
+	list.IteriRev(func(int, int) { count() }, []int{})
+	list.Iter2(func(int, string) { count() }, []int{}, []string{"a"})
+	list.Iter2Rev(func(int, string) { count() }, []int{1}, []string{})
+	list.Iteri2(func(int, int, string) { count() }, []int{}, []string{"a"})
+	list.Iteri2Rev(func(int, int, string) { count() }, []int{1}, []string{})
+	list.Iter3(func(int, string, bool) { count() }, []int{1}, []string{"a"}, []bool{})
+	list.Iter3Rev(func(int, string, bool) { count() }, []int{}, []string{"a"}, []bool{true})
+	list.Iteri3(func(int, int, string, bool) { count() }, []int{1}, []string{}, []bool{true})
+	list.Iteri3Rev(func(int, int, string, bool) { count() }, []int{}, []string{}, []bool{})
+	fmt.Println(calls)
+	// Output: 0
+}
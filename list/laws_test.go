@@ -0,0 +1,139 @@
+package list_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+)
+
+// These properties check laws that should hold for any input, complementing the examples
+// that check fixed inputs. They use a fixed seed so every run checks the same values;
+// change it locally to look for failures with other values.
+
+var (
+	seed    = prop.Seed(20261019)
+	ints    = prop.SliceOf(prop.Int(-1000, 1000))
+	indexed = prop.Bind(ints, func(s []int) prop.Gen[functional.Pair[[]int, int]] {
+		return prop.Map(func(n int) functional.Pair[[]int, int] { return functional.PairOf(s, n) }, prop.Int(-2, len(s)+2))
+	})
+)
+
+func TestReverseLaws(t *testing.T) {
+	prop.ForAll(t, ints, func(s []int) bool {
+		return list.Equal(list.Reverse(list.Reverse(s)), s)
+	}, seed)
+	prop.ForAll(t, prop.PairOf(ints, ints), func(p functional.Pair[[]int, []int]) bool {
+		return list.Equal(list.Reverse(list.Concat(p.First, p.Second)), list.Concat(list.Reverse(p.Second), list.Reverse(p.First)))
+	}, seed)
+}
+
+func TestMapLaws(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	show := func(i int) string { return strconv.Itoa(i) }
+	prop.ForAll(t, ints, func(s []int) bool {
+		return list.Equal(list.Map(show, list.Map(double, s)), list.Map(func(i int) string { return show(double(i)) }, s))
+	}, seed)
+	prop.ForAll(t, ints, func(s []int) bool {
+		return list.Equal(list.Map(func(i int) int { return i }, s), s)
+	}, seed)
+}
+
+func TestFilterLaws(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	prop.ForAll(t, ints, func(s []int) bool {
+		once := list.Filter(even, s...)
+		return list.Equal(list.Filter(even, once...), once) && list.ForAll(even, once)
+	}, seed)
+	prop.ForAll(t, ints, func(s []int) bool {
+		yes, no := list.Partition(even, s)
+		return list.EqualUnordered(list.Concat(yes, no), s)
+	}, seed)
+}
+
+func TestFoldLaws(t *testing.T) {
+	prop.ForAll(t, ints, func(s []int) bool {
+		return list.Fold(func(acc, i int) int { return acc + i }, 0, s) == list.Sum(s)
+	}, seed)
+	prop.ForAll(t, prop.PairOf(ints, ints), func(p functional.Pair[[]int, []int]) bool {
+		return list.Sum(list.Concat(p.First, p.Second)) == list.Sum(p.First)+list.Sum(p.Second)
+	}, seed)
+	prop.ForAll(t, ints, func(s []int) bool {
+		prepend := func(i int, acc []int) []int { return append([]int{i}, acc...) }
+		return list.Equal(list.FoldBack(prepend, s, []int{}), s)
+	}, seed)
+}
+
+func TestTakeSkipLaws(t *testing.T) {
+	prop.ForAll(t, indexed, func(p functional.Pair[[]int, int]) bool {
+		s, n := p.First, p.Second
+		taken := list.Take(n, s)
+		if n < 0 || n > len(s) {
+			return taken.IsNone()
+		}
+		return taken.IsSome() && list.Equal(list.Concat(taken.Value(), list.Skip(n, s)), s)
+	}, seed)
+	prop.ForAll(t, indexed, func(p functional.Pair[[]int, int]) bool {
+		s, n := p.First, p.Second
+		return len(list.Truncate(n, s)) == max(min(n, len(s)), 0)
+	}, seed)
+}
+
+func TestWindowedLaws(t *testing.T) {
+	prop.ForAll(t, indexed, func(p functional.Pair[[]int, int]) bool {
+		s, size := p.First, p.Second
+		windows := list.Windowed(size, s)
+		if size < 1 || size > len(s) {
+			return len(windows) == 0
+		}
+		if len(windows) != len(s)-size+1 {
+			return false
+		}
+		return list.ForAll(func(w functional.Pair[int, []int]) bool {
+			return list.Equal(w.Second, s[w.First:w.First+size])
+		}, list.Indexed(windows))
+	}, seed)
+}
+
+func TestChunkLaws(t *testing.T) {
+	prop.ForAll(t, prop.PairOf(ints, prop.Int(1, 10)), func(p functional.Pair[[]int, int]) bool {
+		chunks := list.ChunkBySize(p.Second, p.First)
+		return list.Equal(list.Concat(chunks...), p.First) &&
+			list.ForAll(func(c []int) bool { return len(c) > 0 && len(c) <= p.Second }, chunks)
+	}, seed)
+}
+
+func TestSortLaws(t *testing.T) {
+	prop.ForAll(t, ints, func(s []int) bool {
+		sorted := list.Sort(s)
+		return list.Equal(list.Sort(sorted), sorted) && list.EqualUnordered(sorted, s) &&
+			list.ForAll(func(p functional.Pair[int, int]) bool { return p.First <= p.Second }, list.Pairwise(sorted))
+	}, seed)
+	prop.ForAll(t, ints, func(s []int) bool {
+		return list.Equal(list.SortDescending(s), list.Reverse(list.Sort(s)))
+	}, seed)
+}
+
+func TestDistinctLaws(t *testing.T) {
+	prop.ForAll(t, ints, func(s []int) bool {
+		d := list.Distinct(s...)
+		return list.Equal(list.Distinct(d...), d) && list.ForAll(func(i int) bool { return list.Contains(i, d...) }, s)
+	}, seed)
+}
+
+func TestZipLaws(t *testing.T) {
+	prop.ForAll(t, prop.SliceOf(prop.PairOf(prop.Int(0, 9), prop.String())), func(pairs []functional.Pair[int, string]) bool {
+		a, b := list.Unzip(pairs)
+		return list.Equal(list.Zip(a, b), pairs)
+	}, seed)
+}
+
+func TestFindLaws(t *testing.T) {
+	positive := func(i int) bool { return i > 0 }
+	prop.ForAll(t, ints, func(s []int) bool {
+		found := list.TryFind(positive, s...)
+		return found.IsSome() == list.Exists(positive, s...) && (found.IsNone() || positive(found.Value()))
+	}, seed)
+}
@@ -0,0 +1,128 @@
+package list
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
+// The functions in this file take a *rand.Rand so results can be reproduced with a fixed seed,
+// for example rand.New(rand.NewPCG(1, 2)). If it is nil, the global source from math/rand/v2 is used.
+
+func randIntN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+func randFloat64(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// Shuffle returns a copy of values in a random order.
+func Shuffle[T any](r *rand.Rand, values []T) []T {
+	output := append([]T{}, values...)
+	for i := len(output) - 1; i > 0; i-- {
+		j := randIntN(r, i+1)
+		output[i], output[j] = output[j], output[i]
+	}
+	return output
+}
+
+// Sample returns n items chosen at random from values without replacement, in a random order.
+// If n is negative or greater than the length of values, it returns a BadArgumentErr.
+func Sample[T any](r *rand.Rand, n int, values []T) ([]T, error) {
+	if n < 0 || n > len(values) {
+		return nil, errors.BadArgument("Sample", "n", "must be between 0 and the number of values").With("n", n).With(errors.LengthKey, len(values))
+	}
+	output := append([]T{}, values...)
+	// a partial Fisher-Yates shuffle: only the first n positions are needed
+	for i := 0; i < n; i++ {
+		j := i + randIntN(r, len(output)-i)
+		output[i], output[j] = output[j], output[i]
+	}
+	return output[:n:n], nil
+}
+
+// RandomItem returns an item chosen at random from values.
+// If values contains no items, it returns the zero value for the type
+// and a IndexOutOfRangeErr.
+func RandomItem[T any](r *rand.Rand, values []T) (T, error) {
+	if len(values) == 0 {
+		return *(new(T)), errors.IndexOutOfRange("RandomItem", 0, 0)
+	}
+	return values[randIntN(r, len(values))], nil
+}
+
+// TryRandomItem returns an item chosen at random from values as an Option.
+// If values contains no items, it returns None.
+func TryRandomItem[T any](r *rand.Rand, values []T) option.Option[T] {
+	if item, err := RandomItem(r, values); err != nil {
+		return option.None[T]()
+	} else {
+		return option.Some(item)
+	}
+}
+
+// WeightedChoice returns an item chosen at random from values, where the chance of each item
+// being chosen is proportional to the result of applying weight to it.
+// If values contains no items, it returns a IndexOutOfRangeErr.
+// If any weight is negative, NaN or infinite, all weights are zero or their total is too large for a float64, it returns a BadArgumentErr.
+func WeightedChoice[T any, W numeric](r *rand.Rand, weight func(T) W, values []T) (T, error) {
+	if len(values) == 0 {
+		return *(new(T)), errors.IndexOutOfRange("WeightedChoice", 0, 0)
+	}
+	weights := Map(func(t T) float64 { return float64(weight(t)) }, values)
+	total := 0.0
+	for i, w := range weights {
+		if w < 0 {
+			return *(new(T)), errors.BadArgument("WeightedChoice", "weight", "must not be negative").With(errors.IndexKey, i)
+		}
+		if math.IsNaN(w) || math.IsInf(w, 0) {
+			return *(new(T)), errors.BadArgument("WeightedChoice", "weight", "must be a finite number").With(errors.IndexKey, i)
+		}
+		total += w
+	}
+	if math.IsInf(total, 0) {
+		return *(new(T)), errors.BadArgument("WeightedChoice", "weight", "must not add up to more than the largest float64")
+	}
+	if total == 0 {
+		return *(new(T)), errors.BadArgument("WeightedChoice", "weight", "must not all be zero")
+	}
+	target := randFloat64(r) * total
+	for i, w := range weights {
+		if target < w {
+			return values[i], nil
+		}
+		target -= w
+	}
+	// rounding can leave target just above the last weight, so fall back to the last item with a weight
+	last := IndexByBack(func(w float64) bool { return w > 0 }, weights)
+	return values[last], nil
+}
+
+// ReservoirSample reads values until the channel is closed and returns n of them chosen at random
+// without replacement, using memory proportional to n rather than to the number of values.
+// If fewer than n values are received, it returns all of them.
+func ReservoirSample[T any](r *rand.Rand, n int, values <-chan T) []T {
+	output := make([]T, 0, max(n, 0))
+	seen := 0
+	for v := range values {
+		seen++
+		switch {
+		case len(output) < n:
+			output = append(output, v)
+		case n > 0:
+			if j := randIntN(r, seen); j < n {
+				output[j] = v
+			}
+		}
+	}
+	return output
+}
@@ -0,0 +1,120 @@
+package list_test
+
+import (
+	"fmt"
+	gomath "math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+func ExampleShuffle() {
+	r := rand.New(rand.NewPCG(1, 2))
+	values := []int{1, 2, 3, 4, 5}
+	shuffled := list.Shuffle(r, values)
+	fmt.Println(values, len(shuffled), list.Sum(shuffled))
+	// Output: [1 2 3 4 5] 5 15
+}
+
+func ExampleSample() {
+	r := rand.New(rand.NewPCG(1, 2))
+	s, err := list.Sample(r, 3, list.RangeTo(9))
+	fmt.Println(len(s), len(list.Distinct(s...)) == len(s), err)
+	_, err = list.Sample(r, 4, []int{1, 2, 3})
+	fmt.Println(err)
+	// Output:
+	// 3 true <nil>
+	// bad argument: Sample(arg=n, n=4, length=3): must be between 0 and the number of values
+}
+
+func ExampleRandomItem() {
+	_, err := list.RandomItem(nil, []string{})
+	fmt.Println(err)
+	fmt.Println(list.TryRandomItem(nil, []string{"only"}))
+	// Output:
+	// index out of range: RandomItem(index=0, length=0)
+	// Some("only")
+}
+
+func ExampleWeightedChoice() {
+	r := rand.New(rand.NewPCG(1, 2))
+	weight := func(s string) int {
+		if s == "control" {
+			return 0
+		}
+		return 1
+	}
+	fmt.Println(list.WeightedChoice(r, weight, []string{"control", "variant"}))
+	// Output: variant <nil>
+}
+
+func ExampleReservoirSample() {
+	r := rand.New(rand.NewPCG(1, 2))
+	fmt.Println(len(list.ReservoirSample(r, 10, list.RangeChan(0, 1000))))
+	fmt.Println(list.ReservoirSample(r, 10, list.RangeChan(1, 3)))
+	// Output:
+	// 10
+	// [1 2 3]
+}
+
+func TestRandomReproducible(t *testing.T) {
+	values := list.RangeTo(99)
+	a := list.Shuffle(rand.New(rand.NewPCG(7, 7)), values)
+	b := list.Shuffle(rand.New(rand.NewPCG(7, 7)), values)
+	if !list.Equal(a, b) {
+		t.Errorf("Shuffle with the same seed gave %v and %v", a, b)
+	}
+	if list.Equal(a, values) {
+		t.Errorf("Shuffle returned the values in their original order")
+	}
+}
+
+func TestWeightedChoiceDistribution(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	counts := map[string]int{}
+	weight := func(s string) float64 { return map[string]float64{"a": 1, "b": 3}[s] }
+	for i := 0; i < 10000; i++ {
+		c, err := list.WeightedChoice(r, weight, []string{"a", "b"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[c]++
+	}
+	if counts["b"] < 7200 || counts["b"] > 7800 {
+		t.Errorf("expected about 7500 of b but got %v", counts)
+	}
+}
+
+func TestWeightedChoiceBadWeights(t *testing.T) {
+	for _, weights := range [][]float64{
+		{gomath.NaN()},
+		{1, gomath.NaN()},
+		{gomath.Inf(1)},
+		{gomath.Inf(-1), 1},
+		{gomath.MaxFloat64, gomath.MaxFloat64},
+		{-1, 2},
+		{0, 0},
+	} {
+		_, err := list.WeightedChoice(nil, func(w float64) float64 { return w }, weights)
+		if !errors.Is(err, errors.BadArgumentErr) {
+			t.Errorf("WeightedChoice with weights %v returned %v, want a BadArgumentErr", weights, err)
+		}
+	}
+}
+
+func TestReservoirSampleUniform(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	counts := make([]int, 10)
+	for i := 0; i < 10000; i++ {
+		for _, v := range list.ReservoirSample(r, 3, list.RangeChan(0, 9)) {
+			counts[v]++
+		}
+	}
+	for v, c := range counts {
+		if c < 2700 || c > 3300 {
+			t.Errorf("value %d was chosen %d times, expected about 3000", v, c)
+		}
+	}
+}
@@ -1,8 +1,6 @@
 package list
 
 import (
-	"fmt"
-
 	"github.com/flowonyx/functional/errors"
 	"golang.org/x/exp/slices"
 )
@@ -11,7 +9,7 @@ import (
 // if the index is outside the range of indexes in values.
 func SetItem[T any](values []T, index int, value T) error {
 	if index < 0 || index > LastIndexOf(values) {
-		return fmt.Errorf("%w: SetItem([%d]%T, %d, _)", errors.IndexOutOfRangeErr, len(values), values, index)
+		return errors.IndexOutOfRange("SetItem", index, len(values))
 	}
 	values[index] = value
 	return nil
@@ -20,22 +18,22 @@ func SetItem[T any](values []T, index int, value T) error {
 // SetItem2D sets values[index1][index2] to value or returns an IndexOutOfRange error
 // if any of the indexes is outside the range of indexes in values.
 func SetItem2D[T any](values [][]T, index1, index2 int, value T) error {
-	if index1 > LastIndexOf(values) {
-		return fmt.Errorf("%w: SetItem2D([%d][_]%T, %d, %d, _)", errors.IndexOutOfRangeErr, len(values), values, index1, index2)
+	if index1 < 0 || index1 > LastIndexOf(values) {
+		return outOfRange("SetItem2D", 1, index1, len(values))
 	}
 	return SetItem(values[index1], index2, value)
 }
 
 func SetItem3D[T any](values [][][]T, index1, index2, index3 int, value T) error {
-	if index1 > LastIndexOf(values) {
-		return fmt.Errorf("%w: SetItem3D([%d][_][_]%T, %d, %d, %d _)", errors.IndexOutOfRangeErr, len(values), values, index1, index2, index3)
+	if index1 < 0 || index1 > LastIndexOf(values) {
+		return outOfRange("SetItem3D", 1, index1, len(values))
 	}
 	return SetItem2D(values[index1], index2, index3, value)
 }
 
 func SetItem4D[T any](values [][][][]T, index1, index2, index3, index4 int, value T) error {
-	if index1 > LastIndexOf(values) {
-		return fmt.Errorf("%w: SetItem3D([%d][_][_][_]%T, %d, %d, %d, %d _)", errors.IndexOutOfRangeErr, len(values), values, index1, index2, index3, index4)
+	if index1 < 0 || index1 > LastIndexOf(values) {
+		return outOfRange("SetItem4D", 1, index1, len(values))
 	}
 	return SetItem3D(values[index1], index2, index3, index4, value)
 }
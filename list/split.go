@@ -1,8 +1,6 @@
 package list
 
 import (
-	"fmt"
-
 	"github.com/flowonyx/functional/errors"
 	"github.com/flowonyx/functional/math"
 )
@@ -11,7 +9,7 @@ import (
 // If index is out of range, it will return an IndexOutOfRangeErr.
 func SplitAt[T any](index int, values []T) ([]T, []T, error) {
 	if index < 0 || index >= len(values) {
-		return nil, nil, fmt.Errorf("%w: SplitAt(%d, [%d]%T)", errors.IndexOutOfRangeErr, index, len(values), values)
+		return nil, nil, errors.IndexOutOfRange("SplitAt", index, len(values))
 	}
 	return values[0:index], values[index:], nil
 }
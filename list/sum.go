@@ -17,3 +17,22 @@ func SumBy[T any, R numeric | ~string](projection func(T) R, values []T) R {
 	}
 	return r
 }
+
+// SumNumbers returns the result of adding all values together with their Add method.
+// It is the equivalent of Sum for types such as decimal.Decimal.
+func SumNumbers[T Number[T]](values []T) T {
+	var r T
+	for _, v := range values {
+		r = r.Add(v)
+	}
+	return r
+}
+
+// SumNumbersBy returns the result of adding all results of applying projection to each value with their Add method.
+func SumNumbersBy[T any, R Number[R]](projection func(T) R, values []T) R {
+	var r R
+	for _, v := range values {
+		r = r.Add(projection(v))
+	}
+	return r
+}
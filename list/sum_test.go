@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/flowonyx/functional/decimal"
 	"github.com/flowonyx/functional/list"
 )
 
@@ -27,3 +28,9 @@ func ExampleSumBy() {
 	fmt.Printf("%s", strconv.FormatFloat(r, 'f', 1, 64))
 	// Output: 3.3
 }
+
+func ExampleSumNumbers() {
+	r := list.SumNumbers([]decimal.Decimal{decimal.MustParse("0.1"), decimal.MustParse("0.2")})
+	fmt.Println(r)
+	// Output: 0.3
+}
@@ -6,12 +6,12 @@ import (
 )
 
 // Take returns an Option of the first count values in the slice.
-// If count exceeds the number of values in the slice,
+// If count is negative or exceeds the number of values in the slice,
 // it returns None.
 // To return all values when count exceeds the number of values,
 // use Truncate.
 func Take[T any](count int, values []T) option.Option[[]T] {
-	if count > LastIndexOf(values) {
+	if count < 0 || count > len(values) {
 		return option.None[[]T]()
 	}
 	return option.Some(slices.Clone(values[:count]))
@@ -30,10 +30,7 @@ func TakeWhile[T any](predicate func(T) bool, values []T) []T {
 
 // Truncate returns the first count values in the slie.
 // If count exceeds the number of values in the slice,
-// it will return all values.
+// it will return all values. If count is negative, it returns an empty slice.
 func Truncate[T any](count int, values []T) []T {
-	if count > LastIndexOf(values) {
-		return slices.Clone(values)
-	}
-	return slices.Clone(values[:count])
+	return slices.Clone(values[:max(min(count, len(values)), 0)])
 }
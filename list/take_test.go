@@ -12,6 +12,11 @@ func ExampleTake() {
 	// Output: [0 1 2]
 }
 
+func ExampleTake_outOfRange() {
+	fmt.Println(list.Take(3, []int{0, 1, 2}), list.Take(-1, []int{0, 1, 2}), list.Take(4, []int{0, 1, 2}))
+	// Output: Some([0 1 2]) None None
+}
+
 func ExampleTakeWhile() {
 	r := list.TakeWhile(func(i int) bool { return i == 0 || i%2 != 0 }, []int{0, 1, 2, 3, 4})
 	fmt.Println(r)
@@ -23,3 +28,8 @@ func ExampleTruncate() {
 	fmt.Println(r)
 	// Output: [0 1 2]
 }
+
+func ExampleTruncate_outOfRange() {
+	fmt.Println(list.Truncate(5, []int{0, 1, 2}), list.Truncate(-1, []int{0, 1, 2}))
+	// Output: [0 1 2] []
+}
@@ -2,7 +2,11 @@ package list
 
 // Windowed returns the values in sliding windows of the size specified by windowSize.
 // Each window is returned as a fresh slice.
+// If windowSize is less than 1 or greater than the number of values, it returns an empty slice.
 func Windowed[T any](windowSize int, values []T) [][]T {
+	if windowSize < 1 || windowSize > len(values) {
+		return [][]T{}
+	}
 	output := Empty[[]T](len(values) / windowSize)
 	temp := Empty[T](windowSize)
 	DoRangeTo(func(i int) {
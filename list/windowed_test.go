@@ -12,3 +12,9 @@ func ExampleWindowed() {
 	fmt.Println(r)
 	// Output: [[1 2 3] [2 3 4] [3 4 5]]
 }
+
+func ExampleWindowed_outOfRange() {
+	input := []int{1, 2, 3}
+	fmt.Println(list.Windowed(0, input), list.Windowed(-1, input), list.Windowed(4, input), list.Windowed(3, input))
+	// Output: [] [] [] [[1 2 3]]
+}
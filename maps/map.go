@@ -2,8 +2,6 @@
 package maps
 
 import (
-	"fmt"
-
 	. "github.com/flowonyx/functional"
 	"github.com/flowonyx/functional/errors"
 	"github.com/flowonyx/functional/list"
@@ -116,7 +114,7 @@ func Find[K comparable, T any](key K, m map[K]T) (T, error) {
 	if v := TryGet(key, m); v.IsSome() {
 		return v.Value(), nil
 	}
-	return *(new(T)), fmt.Errorf("maps.Find(%v): %w", key, errors.KeyNotFoundErr)
+	return *(new(T)), errors.KeyNotFound("maps.Find", key)
 }
 
 // FindKey finds the first key in the map that is matched by the predicate. Remember that no order can be assumed.
@@ -127,7 +125,7 @@ func FindKey[K comparable, T any](predicate func(K, T) bool, m map[K]T) (K, erro
 			return k, nil
 		}
 	}
-	return *(new(K)), fmt.Errorf("maps.FindKey: %w", errors.KeyNotFoundErr)
+	return *(new(K)), errors.Wrap(errors.KeyNotFoundErr, "maps.FindKey")
 }
 
 // TryFindKey is just like FindKey but it returns an option with the value of None if the key is not found.
@@ -0,0 +1,277 @@
+package math
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+	"golang.org/x/exp/constraints"
+)
+
+// BigInt is an immutable arbitrary-size integer wrapping big.Int.
+// Unlike big.Int, its methods return new values, so it can be used with list.Fold,
+// list.SumNumbers and the other functions that work with values.
+// The zero value is 0.
+type BigInt struct {
+	i *big.Int
+}
+
+func bigFromInt[T constraints.Integer](n T) *big.Int {
+	if n > 0 {
+		return new(big.Int).SetUint64(uint64(n))
+	}
+	return big.NewInt(int64(n))
+}
+
+// NewBigInt creates a BigInt from any integer type.
+func NewBigInt[T constraints.Integer](n T) BigInt {
+	return BigInt{i: bigFromInt(n)}
+}
+
+// BigIntFrom creates a BigInt from a copy of i.
+func BigIntFrom(i *big.Int) BigInt {
+	return BigInt{i: new(big.Int).Set(i)}
+}
+
+// ParseBigInt converts a base 10 string such as "-12345678901234567890" to a BigInt.
+// It returns a BadArgumentErr if s is not a valid integer.
+func ParseBigInt[TString ~string](s TString) (BigInt, error) {
+	i, ok := new(big.Int).SetString(string(s), 10)
+	if !ok {
+		return BigInt{}, fmt.Errorf("%w: ParseBigInt(%q)", errors.BadArgumentErr, s)
+	}
+	return BigInt{i: i}, nil
+}
+
+// BigPow10 returns 10**n as a BigInt. If n is negative, it returns 0.
+func BigPow10[T constraints.Integer](n T) BigInt {
+	if n < 0 {
+		return BigInt{}
+	}
+	return BigInt{i: new(big.Int).Exp(big.NewInt(10), bigFromInt(n), nil)}
+}
+
+func (b BigInt) value() *big.Int {
+	if b.i == nil {
+		return new(big.Int)
+	}
+	return b.i
+}
+
+// Big returns a copy of b as a big.Int.
+func (b BigInt) Big() *big.Int {
+	return new(big.Int).Set(b.value())
+}
+
+// Add returns b + b2.
+func (b BigInt) Add(b2 BigInt) BigInt {
+	return BigInt{i: new(big.Int).Add(b.value(), b2.value())}
+}
+
+// Sub returns b - b2.
+func (b BigInt) Sub(b2 BigInt) BigInt {
+	return BigInt{i: new(big.Int).Sub(b.value(), b2.value())}
+}
+
+// Mul returns b * b2.
+func (b BigInt) Mul(b2 BigInt) BigInt {
+	return BigInt{i: new(big.Int).Mul(b.value(), b2.value())}
+}
+
+// Div returns b / b2 truncated toward zero, like the / operator.
+// It panics if b2 is 0.
+func (b BigInt) Div(b2 BigInt) BigInt {
+	return BigInt{i: new(big.Int).Quo(b.value(), b2.value())}
+}
+
+// DivInt returns b / n truncated toward zero, like the / operator.
+// It panics if n is 0.
+func (b BigInt) DivInt(n int) BigInt {
+	return b.Div(NewBigInt(n))
+}
+
+// Rem returns the remainder of b / b2 with the sign of b, like the % operator.
+// It panics if b2 is 0.
+func (b BigInt) Rem(b2 BigInt) BigInt {
+	return BigInt{i: new(big.Int).Rem(b.value(), b2.value())}
+}
+
+// Pow returns b**n.
+func (b BigInt) Pow(n uint) BigInt {
+	return BigInt{i: new(big.Int).Exp(b.value(), new(big.Int).SetUint64(uint64(n)), nil)}
+}
+
+// Neg returns -b.
+func (b BigInt) Neg() BigInt {
+	return BigInt{i: new(big.Int).Neg(b.value())}
+}
+
+// Abs returns the absolute value of b.
+func (b BigInt) Abs() BigInt {
+	return BigInt{i: new(big.Int).Abs(b.value())}
+}
+
+// Cmp compares b and b2 and returns -1 if b < b2, 0 if b == b2 and +1 if b > b2.
+// The method expression BigInt.Cmp can be passed directly to list.SortWith.
+func (b BigInt) Cmp(b2 BigInt) int {
+	return b.value().Cmp(b2.value())
+}
+
+// Equal tests whether b and b2 are equal.
+func (b BigInt) Equal(b2 BigInt) bool {
+	return b.Cmp(b2) == 0
+}
+
+// Sign returns -1 if b is negative, 0 if b is zero and +1 if b is positive.
+func (b BigInt) Sign() int {
+	return b.value().Sign()
+}
+
+// TryInt64 returns b as an int64, or None if it does not fit.
+func (b BigInt) TryInt64() option.Option[int64] {
+	if !b.value().IsInt64() {
+		return option.None[int64]()
+	}
+	return option.Some(b.value().Int64())
+}
+
+// String returns b in base 10.
+func (b BigInt) String() string {
+	return b.value().String()
+}
+
+// BigRat is an immutable arbitrary-precision fraction wrapping big.Rat.
+// Unlike big.Rat, its methods return new values, so it can be used with list.Fold,
+// list.SumNumbers and the other functions that work with values.
+// The zero value is 0.
+type BigRat struct {
+	r *big.Rat
+}
+
+// NewBigRat creates a BigRat equal to num/den from any integer type.
+// It returns a BadArgumentErr if den is 0.
+func NewBigRat[T constraints.Integer](num, den T) (BigRat, error) {
+	if den == 0 {
+		return BigRat{}, fmt.Errorf("%w: NewBigRat(%d, 0): denominator must not be 0", errors.BadArgumentErr, num)
+	}
+	return BigRat{r: new(big.Rat).SetFrac(bigFromInt(num), bigFromInt(den))}, nil
+}
+
+// BigRatFrom creates a BigRat from a copy of r.
+func BigRatFrom(r *big.Rat) BigRat {
+	return BigRat{r: new(big.Rat).Set(r)}
+}
+
+// ParseBigRat converts a fraction such as "3/4" or a decimal such as "-1.25" or "1e-3" to a BigRat.
+// It returns a BadArgumentErr if s is not a valid number.
+func ParseBigRat[TString ~string](s TString) (BigRat, error) {
+	r, ok := new(big.Rat).SetString(string(s))
+	if !ok {
+		return BigRat{}, fmt.Errorf("%w: ParseBigRat(%q)", errors.BadArgumentErr, s)
+	}
+	return BigRat{r: r}, nil
+}
+
+func (b BigRat) value() *big.Rat {
+	if b.r == nil {
+		return new(big.Rat)
+	}
+	return b.r
+}
+
+// Big returns a copy of b as a big.Rat.
+func (b BigRat) Big() *big.Rat {
+	return new(big.Rat).Set(b.value())
+}
+
+// Num returns the numerator of b in lowest terms.
+func (b BigRat) Num() BigInt {
+	return BigIntFrom(b.value().Num())
+}
+
+// Denom returns the denominator of b in lowest terms, which is always positive.
+func (b BigRat) Denom() BigInt {
+	return BigIntFrom(b.value().Denom())
+}
+
+// Add returns b + b2.
+func (b BigRat) Add(b2 BigRat) BigRat {
+	return BigRat{r: new(big.Rat).Add(b.value(), b2.value())}
+}
+
+// Sub returns b - b2.
+func (b BigRat) Sub(b2 BigRat) BigRat {
+	return BigRat{r: new(big.Rat).Sub(b.value(), b2.value())}
+}
+
+// Mul returns b * b2.
+func (b BigRat) Mul(b2 BigRat) BigRat {
+	return BigRat{r: new(big.Rat).Mul(b.value(), b2.value())}
+}
+
+// Div returns b / b2.
+// It panics if b2 is 0.
+func (b BigRat) Div(b2 BigRat) BigRat {
+	return BigRat{r: new(big.Rat).Quo(b.value(), b2.value())}
+}
+
+// DivInt returns b / n.
+// It panics if n is 0.
+func (b BigRat) DivInt(n int) BigRat {
+	return b.Div(BigRat{r: new(big.Rat).SetInt64(int64(n))})
+}
+
+// Neg returns -b.
+func (b BigRat) Neg() BigRat {
+	return BigRat{r: new(big.Rat).Neg(b.value())}
+}
+
+// Abs returns the absolute value of b.
+func (b BigRat) Abs() BigRat {
+	return BigRat{r: new(big.Rat).Abs(b.value())}
+}
+
+// Inv returns 1/b.
+// It panics if b is 0.
+func (b BigRat) Inv() BigRat {
+	return BigRat{r: new(big.Rat).Inv(b.value())}
+}
+
+// Cmp compares b and b2 and returns -1 if b < b2, 0 if b == b2 and +1 if b > b2.
+// The method expression BigRat.Cmp can be passed directly to list.SortWith.
+func (b BigRat) Cmp(b2 BigRat) int {
+	return b.value().Cmp(b2.value())
+}
+
+// Equal tests whether b and b2 are equal.
+func (b BigRat) Equal(b2 BigRat) bool {
+	return b.Cmp(b2) == 0
+}
+
+// Sign returns -1 if b is negative, 0 if b is zero and +1 if b is positive.
+func (b BigRat) Sign() int {
+	return b.value().Sign()
+}
+
+// IsInt tests whether b is a whole number.
+func (b BigRat) IsInt() bool {
+	return b.value().IsInt()
+}
+
+// Float64 returns the nearest float64 to b.
+func (b BigRat) Float64() float64 {
+	f, _ := b.value().Float64()
+	return f
+}
+
+// FloatString returns b as a decimal with places digits after the decimal point.
+// The last digit is rounded to nearest, with halves rounded away from zero.
+func (b BigRat) FloatString(places int) string {
+	return b.value().FloatString(places)
+}
+
+// String returns b in the form "num/den", or just "num" if it is a whole number.
+func (b BigRat) String() string {
+	return b.value().RatString()
+}
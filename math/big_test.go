@@ -0,0 +1,47 @@
+package math_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/math"
+)
+
+func ExampleBigInt() {
+	factorial := list.Fold(func(acc math.BigInt, n int) math.BigInt {
+		return acc.Mul(math.NewBigInt(n))
+	}, math.NewBigInt(1), list.RangeTo(25)[1:])
+	fmt.Println(factorial)
+	fmt.Println(factorial.TryInt64())
+	// Output:
+	// 15511210043330985984000000
+	// None
+}
+
+func ExampleBigPow10() {
+	fmt.Println(math.BigPow10(30).Add(math.NewBigInt(1)))
+	// Output: 1000000000000000000000000000001
+}
+
+func ExampleBigInt_Cmp() {
+	values := list.Map(func(s string) math.BigInt {
+		b, _ := math.ParseBigInt(s)
+		return b
+	}, []string{"99999999999999999999", "-5", "123"})
+	fmt.Println(list.SortWith(math.BigInt.Cmp, values))
+	fmt.Println(list.SumNumbers(values))
+	// Output:
+	// [-5 123 99999999999999999999]
+	// 100000000000000000117
+}
+
+func ExampleBigRat() {
+	r, _ := math.ParseBigRat("0.1")
+	total := list.SumNumbers([]math.BigRat{r, r, r})
+	fmt.Println(total, total.FloatString(2))
+	half, _ := math.NewBigRat(1, 2)
+	fmt.Println(total.Mul(half), total.Inv(), math.MustNewRational(3, 4).BigRat().Add(half))
+	// Output:
+	// 3/10 0.30
+	// 3/20 10/3 5/4
+}
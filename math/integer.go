@@ -0,0 +1,182 @@
+package math
+
+import (
+	"unsafe"
+
+	"github.com/flowonyx/functional/option"
+	"golang.org/x/exp/constraints"
+)
+
+// GCD returns the greatest common divisor of a and b.
+// The result is never negative and GCD(0, 0) is 0, except when the result would be the
+// magnitude of the minimum value of a signed T, which does not fit in T.
+// For example, GCD(math.MinInt64, 0) returns math.MinInt64, as -math.MinInt64 overflows back to itself.
+func GCD[T constraints.Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// LCM returns the least common multiple of a and b.
+// The result is never negative and it is 0 if either a or b is 0.
+// Like the builtin operators, it silently overflows if the result does not fit in T.
+func LCM[T constraints.Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	l := a / GCD(a, b) * b
+	if l < 0 {
+		return -l
+	}
+	return l
+}
+
+// IsPrime tests whether n is a prime number.
+func IsPrime[T constraints.Integer](n T) bool {
+	if n < 2 {
+		return false
+	}
+	if n < 4 {
+		return true
+	}
+	if n%2 == 0 || n%3 == 0 {
+		return false
+	}
+	for i := T(5); i <= n/i; i += 6 {
+		if n%i == 0 || n%(i+2) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Factorize returns the prime factors of n in ascending order, repeating factors that divide n more than once.
+// If n is less than 2, it returns an empty slice.
+func Factorize[T constraints.Integer](n T) []T {
+	factors := []T{}
+	if n < 2 {
+		return factors
+	}
+	for n%2 == 0 {
+		factors = append(factors, 2)
+		n /= 2
+	}
+	for i := T(3); i <= n/i; i += 2 {
+		for n%i == 0 {
+			factors = append(factors, i)
+			n /= i
+		}
+	}
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	return factors
+}
+
+// DivMod returns the quotient and remainder of a divided by b using floor division,
+// so the quotient is rounded toward negative infinity and the remainder has the same sign as b.
+// This differs from the / and % operators, which truncate toward zero.
+// It panics if b is 0.
+func DivMod[T constraints.Integer](a, b T) (quotient, remainder T) {
+	quotient, remainder = a/b, a%b
+	if remainder != 0 && (remainder < 0) != (b < 0) {
+		quotient--
+		remainder += b
+	}
+	return quotient, remainder
+}
+
+// AddChecked returns Some(a + b), or None if the addition overflows T.
+func AddChecked[T constraints.Integer](a, b T) option.Option[T] {
+	c := a + b
+	if isSigned[T]() {
+		if (a > 0 && b > 0 && c < 0) || (a < 0 && b < 0 && c >= 0) {
+			return option.None[T]()
+		}
+	} else if c < a {
+		return option.None[T]()
+	}
+	return option.Some(c)
+}
+
+// SubChecked returns Some(a - b), or None if the subtraction overflows T.
+func SubChecked[T constraints.Integer](a, b T) option.Option[T] {
+	c := a - b
+	if isSigned[T]() {
+		if (b > 0 && c > a) || (b < 0 && c < a) {
+			return option.None[T]()
+		}
+	} else if b > a {
+		return option.None[T]()
+	}
+	return option.Some(c)
+}
+
+// MulChecked returns Some(a * b), or None if the multiplication overflows T.
+func MulChecked[T constraints.Integer](a, b T) option.Option[T] {
+	if a == 0 || b == 0 {
+		return option.Some(T(0))
+	}
+	c := a * b
+	if c/b != a {
+		return option.None[T]()
+	}
+	// the minimum signed value times -1 overflows back to itself, which the division check cannot catch
+	var zero T
+	minusOne := zero - 1
+	if isSigned[T]() && ((b == minusOne && a == minValue[T]()) || (a == minusOne && b == minValue[T]())) {
+		return option.None[T]()
+	}
+	return option.Some(c)
+}
+
+// SaturatingAdd returns a + b, or the maximum or minimum value of T if the addition overflows.
+func SaturatingAdd[T constraints.Integer](a, b T) T {
+	if r := AddChecked(a, b); r.IsSome() {
+		return r.Value()
+	}
+	if b > 0 {
+		return maxValue[T]()
+	}
+	return minValue[T]()
+}
+
+// SaturatingSub returns a - b, or the maximum or minimum value of T if the subtraction overflows.
+func SaturatingSub[T constraints.Integer](a, b T) T {
+	if r := SubChecked(a, b); r.IsSome() {
+		return r.Value()
+	}
+	if b < 0 {
+		return maxValue[T]()
+	}
+	return minValue[T]()
+}
+
+func isSigned[T constraints.Integer]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+func bitSize[T constraints.Integer]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+func maxValue[T constraints.Integer]() T {
+	if isSigned[T]() {
+		return T(uint64(1)<<(bitSize[T]()-1) - 1)
+	}
+	var zero T
+	return ^zero
+}
+
+func minValue[T constraints.Integer]() T {
+	if isSigned[T]() {
+		return -maxValue[T]() - 1
+	}
+	return 0
+}
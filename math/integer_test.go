@@ -0,0 +1,67 @@
+package math_test
+
+import (
+	"fmt"
+	gomath "math"
+
+	"github.com/flowonyx/functional/math"
+)
+
+func ExampleClamp() {
+	fmt.Println(math.Clamp(15, 0, 10), math.Clamp(-5, 0, 10), math.Clamp(5, 0, 10))
+	// Output: 10 0 5
+}
+
+func ExampleLerp() {
+	fmt.Println(math.Lerp(10.0, 20.0, 0.25), math.InverseLerp(10.0, 20.0, 12.5))
+	// Output: 12.5 0.25
+}
+
+func ExampleSign() {
+	fmt.Println(math.Sign(-3), math.Sign(0.0), math.Sign(int8(7)))
+	// Output: -1 0 1
+}
+
+func ExampleGCD() {
+	fmt.Println(math.GCD(12, 18), math.GCD(-12, 18), math.LCM(4, 6), math.LCM(0, 6))
+	fmt.Println(math.GCD(int8(-128), 0), math.LCM(int8(100), 3))
+	// Output:
+	// 6 6 12 0
+	// -128 44
+}
+
+func ExampleIsPrime() {
+	fmt.Println(math.IsPrime(2), math.IsPrime(97), math.IsPrime(91), math.IsPrime(1))
+	// Output: true true false false
+}
+
+func ExampleFactorize() {
+	fmt.Println(math.Factorize(360), math.Factorize(uint64(9999999967)), math.Factorize(1))
+	// Output: [2 2 2 3 3 5] [9999999967] []
+}
+
+func ExampleDivMod() {
+	q, r := math.DivMod(-7, 2)
+	fmt.Println(q, r, -7/2, -7%2)
+	// Output: -4 1 -3 -1
+}
+
+func ExampleAddChecked() {
+	fmt.Println(math.AddChecked[int8](100, 27), math.AddChecked[int8](100, 28), math.AddChecked[uint8](200, 56))
+	// Output: Some(127) None None
+}
+
+func ExampleSubChecked() {
+	fmt.Println(math.SubChecked[uint](3, 5), math.SubChecked[int8](-100, 28), math.SubChecked[int8](-100, 29))
+	// Output: None Some(-128) None
+}
+
+func ExampleMulChecked() {
+	fmt.Println(math.MulChecked[int64](gomath.MinInt64, -1), math.MulChecked[int64](1<<32, 1<<31), math.MulChecked[int64](-1<<32, 1<<31))
+	// Output: None None Some(-9223372036854775808)
+}
+
+func ExampleSaturatingAdd() {
+	fmt.Println(math.SaturatingAdd[int8](100, 100), math.SaturatingAdd[int8](-100, -100), math.SaturatingSub[uint8](3, 5), math.SaturatingSub[int16](-30000, 30000))
+	// Output: 127 -128 0 -32768
+}
@@ -0,0 +1,35 @@
+package math
+
+import "golang.org/x/exp/constraints"
+
+// Clamp returns x limited to the range lo to hi (inclusive).
+// If lo is greater than hi, the result is undefined.
+func Clamp[T constraints.Ordered](x, lo, hi T) T {
+	return Min(Max(x, lo), hi)
+}
+
+// Lerp returns the linear interpolation between a and b by the fraction t.
+// When t is 0 it returns a and when t is 1 it returns b. Values of t outside 0 to 1 extrapolate.
+func Lerp[T constraints.Float](a, b, t T) T {
+	return a + (b-a)*t
+}
+
+// InverseLerp returns the fraction t such that Lerp(a, b, t) == v.
+// If a equals b, it returns 0.
+func InverseLerp[T constraints.Float](a, b, v T) T {
+	if a == b {
+		return 0
+	}
+	return (v - a) / (b - a)
+}
+
+// Sign returns -1 if x is negative, 1 if x is positive and 0 if x is zero (or NaN).
+func Sign[T constraints.Signed | constraints.Float](x T) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	}
+	return 0
+}
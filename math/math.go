@@ -31,6 +31,18 @@ func Round[T constraints.Float](x T) T {
 	return T(math.Round(float64(x)))
 }
 
+// Rounder is implemented by numeric types that round themselves to a number of decimal places,
+// such as decimal.Decimal.
+type Rounder[T any] interface {
+	Round(places int) T
+}
+
+// RoundNumber returns the nearest integer to x using its Round method.
+// It is the equivalent of Round for types such as decimal.Decimal.
+func RoundNumber[T Rounder[T]](x T) T {
+	return x.Round(0)
+}
+
 // RoundToEven returns the nearest integer as the float type of x, rounding ties to even.
 func RoundToEven[T constraints.Float](x T) T {
 	return T(math.RoundToEven(float64(x)))
@@ -62,10 +74,24 @@ func Pow[T numeric](x, y T) T {
 }
 
 // Pow10 returns 10**n, the base-10 exponential of n.
+// The result silently overflows if it does not fit in T.
+// Use Pow10Checked or BigPow10 when that is possible.
 func Pow10[T constraints.Integer](x T) T {
 	return T(math.Pow10(int(x)))
 }
 
+// Pow10Checked returns Some(10**n), or None if n is negative or the result overflows T.
+func Pow10Checked[T constraints.Integer](n T) option.Option[T] {
+	if n < 0 {
+		return option.None[T]()
+	}
+	r := option.Some(T(1))
+	for i := T(0); i < n && r.IsSome(); i++ {
+		r = MulChecked(r.Value(), 10)
+	}
+	return r
+}
+
 // Remainder returns the IEEE 754 floating-point remainder of x/y.
 func Remainder[T numeric](x, y T) T {
 	return T(math.Remainder(float64(x), float64(y)))
@@ -0,0 +1,199 @@
+package math
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/flowonyx/functional/errors"
+	"golang.org/x/exp/constraints"
+)
+
+// Rational is an exact fraction with a numerator and denominator of type T.
+// It is always kept in lowest terms with a positive denominator, so equal values have equal fields
+// and Rationals can be compared with ==.
+// The zero value is 0 and is equal to every other 0 with ==.
+//
+// Like the builtin operators, arithmetic silently overflows if a result does not fit in T.
+// Common factors are removed before multiplying to delay that as long as possible.
+// The one exception is a result whose denominator in lowest terms is the minimum value of a signed T,
+// such as 1/-128 for int8: it cannot be given a positive denominator, so arithmetic panics.
+// Use BigRat when the values are unbounded.
+type Rational[T constraints.Integer] struct {
+	// denMinus1 is the denominator less 1, so the zero value is 0/1 like every other 0.
+	num, denMinus1 T
+}
+
+func rational[T constraints.Integer](num, den T) Rational[T] {
+	return Rational[T]{num: num, denMinus1: den - 1}
+}
+
+// NewRational creates a Rational equal to num/den in lowest terms.
+// It returns a BadArgumentErr if den is 0, or if the denominator in lowest terms is the minimum value
+// of a signed T, which has no positive counterpart.
+func NewRational[T constraints.Integer](num, den T) (Rational[T], error) {
+	if den == 0 {
+		return Rational[T]{}, fmt.Errorf("%w: NewRational(%d, 0): denominator must not be 0", errors.BadArgumentErr, num)
+	}
+	r, ok := normalizeRational(num, den)
+	if !ok {
+		return Rational[T]{}, fmt.Errorf("%w: NewRational(%d, %d): denominator cannot be made positive in %T", errors.BadArgumentErr, num, den, den)
+	}
+	return r, nil
+}
+
+// MustNewRational is the same as NewRational but panics if it would return an error.
+func MustNewRational[T constraints.Integer](num, den T) Rational[T] {
+	r, err := NewRational(num, den)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RationalFromInt creates a Rational equal to the integer n.
+func RationalFromInt[T constraints.Integer](n T) Rational[T] {
+	return rational(n, 1)
+}
+
+// normalizeRational returns num/den in lowest terms with a positive denominator.
+// It returns false if the denominator is the minimum value of T, which cannot be negated.
+func normalizeRational[T constraints.Integer](num, den T) (Rational[T], bool) {
+	if num == 0 {
+		return Rational[T]{}, true
+	}
+	g := GCD(num, den)
+	if g < 0 {
+		// GCD only overflows when num and den are both the minimum value of T
+		return rational[T](1, 1), true
+	}
+	if g > 1 {
+		num, den = num/g, den/g
+	}
+	if den < 0 {
+		num, den = -num, -den
+		if den < 0 {
+			return Rational[T]{}, false
+		}
+	}
+	return rational(num, den), true
+}
+
+// mustNormalizeRational is normalizeRational for arithmetic, which panics rather than return a negative denominator.
+func mustNormalizeRational[T constraints.Integer](num, den T) Rational[T] {
+	r, ok := normalizeRational(num, den)
+	if !ok {
+		panic(fmt.Sprintf("Rational denominator %d cannot be made positive", den))
+	}
+	return r
+}
+
+// Num returns the numerator of r.
+func (r Rational[T]) Num() T {
+	return r.num
+}
+
+// Denom returns the denominator of r, which is always positive.
+func (r Rational[T]) Denom() T {
+	return r.denMinus1 + 1
+}
+
+// Add returns r + r2.
+func (r Rational[T]) Add(r2 Rational[T]) Rational[T] {
+	// a/b + c/d = (a*(d/g) + c*(b/g)) / (b/g*d) where g = gcd(b, d)
+	b, d := r.Denom(), r2.Denom()
+	g := GCD(b, d)
+	return mustNormalizeRational(r.num*(d/g)+r2.num*(b/g), b/g*d)
+}
+
+// Sub returns r - r2.
+func (r Rational[T]) Sub(r2 Rational[T]) Rational[T] {
+	return r.Add(r2.Neg())
+}
+
+// Mul returns r * r2.
+func (r Rational[T]) Mul(r2 Rational[T]) Rational[T] {
+	// cancel across the fractions first so the products are as small as possible
+	g1, g2 := max(GCD(r.num, r2.Denom()), 1), max(GCD(r2.num, r.Denom()), 1)
+	return mustNormalizeRational((r.num/g1)*(r2.num/g2), (r.Denom()/g2)*(r2.Denom()/g1))
+}
+
+// Div returns r / r2.
+// It panics if r2 is 0, like integer division.
+func (r Rational[T]) Div(r2 Rational[T]) Rational[T] {
+	return r.Mul(r2.Inv())
+}
+
+// DivInt returns r / n.
+// It panics if n is 0, like integer division.
+func (r Rational[T]) DivInt(n int) Rational[T] {
+	return r.Div(RationalFromInt(T(n)))
+}
+
+// Neg returns -r.
+func (r Rational[T]) Neg() Rational[T] {
+	return rational(-r.num, r.Denom())
+}
+
+// Abs returns the absolute value of r.
+func (r Rational[T]) Abs() Rational[T] {
+	if r.num < 0 {
+		return r.Neg()
+	}
+	return r
+}
+
+// Inv returns 1/r.
+// It panics if r is 0, like integer division, or if r.Num() is the minimum value of a signed T.
+func (r Rational[T]) Inv() Rational[T] {
+	if r.num == 0 {
+		panic("Rational division by zero")
+	}
+	return mustNormalizeRational(r.Denom(), r.num)
+}
+
+// Cmp compares r and r2 and returns -1 if r < r2, 0 if r == r2 and +1 if r > r2.
+// The comparison is exact and does not overflow.
+// The method expression Rational[T].Cmp can be passed directly to list.SortWith.
+func (r Rational[T]) Cmp(r2 Rational[T]) int {
+	return r.BigRat().Cmp(r2.BigRat())
+}
+
+// Equal tests whether r and r2 are equal.
+func (r Rational[T]) Equal(r2 Rational[T]) bool {
+	return r.num == r2.num && r.Denom() == r2.Denom()
+}
+
+// Sign returns -1 if r is negative, 0 if r is zero and +1 if r is positive.
+func (r Rational[T]) Sign() int {
+	switch {
+	case r.num < 0:
+		return -1
+	case r.num > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsInt tests whether r is a whole number.
+func (r Rational[T]) IsInt() bool {
+	return r.Denom() == 1
+}
+
+// Float64 returns the nearest float64 to r.
+func (r Rational[T]) Float64() float64 {
+	f, _ := r.BigRat().r.Float64()
+	return f
+}
+
+// BigRat converts r to a BigRat.
+func (r Rational[T]) BigRat() BigRat {
+	return BigRat{r: new(big.Rat).SetFrac(bigFromInt(r.num), bigFromInt(r.Denom()))}
+}
+
+// String returns r in the form "num/den", or just "num" if the denominator is 1.
+func (r Rational[T]) String() string {
+	if r.IsInt() {
+		return fmt.Sprint(r.num)
+	}
+	return fmt.Sprintf("%d/%d", r.num, r.Denom())
+}
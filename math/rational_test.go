@@ -0,0 +1,62 @@
+package math_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/math"
+)
+
+func ExampleNewRational() {
+	r, err := math.NewRational(6, -8)
+	fmt.Println(r, r.Num(), r.Denom(), err)
+	_, err = math.NewRational(1, 0)
+	fmt.Println(err)
+	// Output:
+	// -3/4 -3 4 <nil>
+	// bad argument: NewRational(1, 0): denominator must not be 0
+}
+
+func ExampleNewRational_minimumDenominator() {
+	r, err := math.NewRational[int8](2, -128)
+	fmt.Println(r, r.Denom(), err)
+	_, err = math.NewRational[int8](1, -128)
+	fmt.Println(err)
+	fmt.Println(math.MustNewRational[int8](-128, -128), math.MustNewRational[int8](0, -128))
+	// Output:
+	// -1/64 64 <nil>
+	// bad argument: NewRational(1, -128): denominator cannot be made positive in int8
+	// 1 0
+}
+
+func ExampleRational_Add() {
+	third := math.MustNewRational(1, 3)
+	sixth := math.MustNewRational(1, 6)
+	fmt.Println(third.Add(sixth), third.Sub(sixth), third.Mul(sixth), third.Div(sixth))
+	// Output: 1/2 1/6 1/18 2
+}
+
+func ExampleRational_zero() {
+	var zero math.Rational[int]
+	half := math.MustNewRational(1, 2)
+	fmt.Println(zero, half.Sub(half) == zero, zero == math.RationalFromInt(0), zero.Add(half) == half)
+	// Output: 0 true true true
+}
+
+func ExampleRational_Cmp() {
+	values := []math.Rational[int64]{
+		math.MustNewRational[int64](2, 3),
+		math.MustNewRational[int64](-1, 2),
+		math.MustNewRational[int64](3, 5),
+	}
+	fmt.Println(list.SortWith(math.Rational[int64].Cmp, values))
+	fmt.Println(list.SumNumbers(values), list.AverageNumbers(values...).Float64())
+	// Output:
+	// [-1/2 3/5 2/3]
+	// 23/30 0.25555555555555554
+}
+
+func ExamplePow10Checked() {
+	fmt.Println(math.Pow10Checked[int64](18), math.Pow10Checked[int64](19))
+	// Output: Some(1000000000000000000) None
+}
@@ -0,0 +1,13 @@
+package math_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/decimal"
+	"github.com/flowonyx/functional/math"
+)
+
+func ExampleRoundNumber() {
+	fmt.Println(math.RoundNumber(decimal.MustParse("2.5")), math.RoundNumber(decimal.MustParse("-2.5")))
+	// Output: 3 -3
+}
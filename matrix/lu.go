@@ -0,0 +1,183 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"golang.org/x/exp/constraints"
+)
+
+// LU is the LU decomposition of a square matrix with partial pivoting,
+// so that the rows of the matrix permuted by Pivot equal L * U.
+// Decomposing once is cheaper than calling Determinant, Inverse or Solve repeatedly on the same matrix.
+type LU[T constraints.Float] struct {
+	// lu holds U on and above the diagonal and L, without its unit diagonal, below it.
+	lu    [][]T
+	pivot []int
+	sign  T
+}
+
+// Decompose computes the LU decomposition of the square matrix m.
+// It returns a BadArgumentErr if m is not square.
+// A singular matrix can be decomposed, but Inverse and Solve will return an error for it.
+func Decompose[T constraints.Float](m [][]T) (LU[T], error) {
+	rows, cols, err := Shape(m)
+	if err != nil {
+		return LU[T]{}, fmt.Errorf("Decompose: %w", err)
+	}
+	if rows != cols {
+		return LU[T]{}, fmt.Errorf("%w: Decompose(%dx%d): matrix must be square", errors.BadArgumentErr, rows, cols)
+	}
+	n := len(m)
+	d := LU[T]{lu: Clone(m), pivot: make([]int, n), sign: 1}
+	for i := range d.pivot {
+		d.pivot[i] = i
+	}
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if abs(d.lu[i][k]) > abs(d.lu[p][k]) {
+				p = i
+			}
+		}
+		if p != k {
+			d.lu[p], d.lu[k] = d.lu[k], d.lu[p]
+			d.pivot[p], d.pivot[k] = d.pivot[k], d.pivot[p]
+			d.sign = -d.sign
+		}
+		if d.lu[k][k] == 0 {
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			d.lu[i][k] /= d.lu[k][k]
+			for j := k + 1; j < n; j++ {
+				d.lu[i][j] -= d.lu[i][k] * d.lu[k][j]
+			}
+		}
+	}
+	return d, nil
+}
+
+// L returns the lower triangular factor, which has ones on its diagonal.
+func (d LU[T]) L() [][]T {
+	output := Identity[T](len(d.lu))
+	for i := range output {
+		copy(output[i][:i], d.lu[i][:i])
+	}
+	return output
+}
+
+// U returns the upper triangular factor.
+func (d LU[T]) U() [][]T {
+	output := Clone(d.lu)
+	for i := range output {
+		clear(output[i][:i])
+	}
+	return output
+}
+
+// Pivot returns the row permutation: row i of L * U is row Pivot()[i] of the original matrix.
+func (d LU[T]) Pivot() []int {
+	return append([]int{}, d.pivot...)
+}
+
+// Determinant returns the determinant of the decomposed matrix.
+func (d LU[T]) Determinant() T {
+	det := d.sign
+	for i := range d.lu {
+		det *= d.lu[i][i]
+	}
+	return det
+}
+
+// IsSingular tests whether the decomposed matrix has no inverse.
+func (d LU[T]) IsSingular() bool {
+	for i := range d.lu {
+		if d.lu[i][i] == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Solve returns x such that m * x = b, where m is the decomposed matrix.
+// It returns a BadArgumentErr if the length of b does not match or the matrix is singular.
+func (d LU[T]) Solve(b []T) ([]T, error) {
+	n := len(d.lu)
+	if len(b) != n {
+		return nil, fmt.Errorf("%w: Solve(%dx%d, %d): vector length must equal the size of the matrix", errors.BadArgumentErr, n, n, len(b))
+	}
+	if d.IsSingular() {
+		return nil, fmt.Errorf("%w: Solve: matrix is singular", errors.BadArgumentErr)
+	}
+	x := make([]T, n)
+	for i := range x {
+		x[i] = b[d.pivot[i]]
+		for j := 0; j < i; j++ {
+			x[i] -= d.lu[i][j] * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.lu[i][j] * x[j]
+		}
+		x[i] /= d.lu[i][i]
+	}
+	return x, nil
+}
+
+// Inverse returns the inverse of the decomposed matrix.
+// It returns a BadArgumentErr if the matrix is singular.
+func (d LU[T]) Inverse() ([][]T, error) {
+	n := len(d.lu)
+	columns := make([][]T, n)
+	unit := make([]T, n)
+	for j := range columns {
+		clear(unit)
+		unit[j] = 1
+		column, err := d.Solve(unit)
+		if err != nil {
+			return nil, fmt.Errorf("Inverse: %w", err)
+		}
+		columns[j] = column
+	}
+	return list.Transpose(columns), nil
+}
+
+// Determinant returns the determinant of the square matrix m.
+// It returns a BadArgumentErr if m is not square.
+func Determinant[T constraints.Float](m [][]T) (T, error) {
+	d, err := Decompose(m)
+	if err != nil {
+		return 0, err
+	}
+	return d.Determinant(), nil
+}
+
+// Inverse returns the inverse of the square matrix m.
+// It returns a BadArgumentErr if m is not square or is singular.
+func Inverse[T constraints.Float](m [][]T) ([][]T, error) {
+	d, err := Decompose(m)
+	if err != nil {
+		return nil, err
+	}
+	return d.Inverse()
+}
+
+// Solve returns x such that a * x = b.
+// It returns a BadArgumentErr if a is not square, the length of b does not match or a is singular.
+func Solve[T constraints.Float](a [][]T, b []T) ([]T, error) {
+	d, err := Decompose(a)
+	if err != nil {
+		return nil, err
+	}
+	return d.Solve(b)
+}
+
+func abs[T constraints.Float](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,60 @@
+package matrix_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/matrix"
+)
+
+func ExampleDeterminant() {
+	det, _ := matrix.Determinant([][]float64{{2, 0, 1}, {1, 3, 2}, {1, 1, 2}})
+	fmt.Printf("%.2f\n", det)
+	_, err := matrix.Determinant([][]float64{{1, 2, 3}, {4, 5, 6}})
+	fmt.Println(err)
+	// Output:
+	// 6.00
+	// bad argument: Decompose(2x3): matrix must be square
+}
+
+func ExampleInverse() {
+	inv, _ := matrix.Inverse([][]float64{{4, 7}, {2, 6}})
+	fmt.Println(matrix.Map(func(x float64) string { return fmt.Sprintf("%.2f", x) }, inv))
+	fmt.Println(matrix.Inverse([][]float64{{1, 2}, {2, 4}}))
+	// Output:
+	// [[0.60 -0.70] [-0.20 0.40]]
+	// [] Inverse: bad argument: Solve: matrix is singular
+}
+
+func ExampleSolve() {
+	// 2x + y = 5 and x - y = 1
+	fmt.Println(matrix.Solve([][]float64{{2, 1}, {1, -1}}, []float64{5, 1}))
+	// Output: [2 1] <nil>
+}
+
+func ExampleDecompose() {
+	d, _ := matrix.Decompose([][]float64{{1, 2}, {3, 4}})
+	fmt.Println(d.L(), d.U(), d.Pivot())
+	// Output: [[1 0] [0.3333333333333333 1]] [[3 4] [0 0.6666666666666667]] [1 0]
+}
+
+func TestInverse(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for n := 1; n <= 6; n++ {
+		m := list.InitSlice2D(func(int, int) float64 { return r.Float64()*10 - 5 }, n, n)
+		inv, err := matrix.Inverse(m)
+		if err != nil {
+			t.Fatalf("Inverse(%v): %v", m, err)
+		}
+		product, _ := matrix.Multiply(m, inv)
+		identity := matrix.Identity[float64](n)
+		list.Iteri2D(func(i, j int, x float64) {
+			if math.Abs(x-identity[i][j]) > 1e-9 {
+				t.Errorf("m * Inverse(m) for %v at %d,%d = %v", m, i, j, x)
+			}
+		}, product)
+	}
+}
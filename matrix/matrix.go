@@ -0,0 +1,192 @@
+// Package matrix provides numerical operations on matrices and vectors stored as [][]T and []T,
+// the same two dimensional slices created by list.Create2D and list.InitSlice2D.
+// Inputs are never modified; every result is a new slice.
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"golang.org/x/exp/constraints"
+)
+
+type numeric interface {
+	constraints.Float | constraints.Integer
+}
+
+// Shape returns the number of rows and columns in m.
+// It returns a BadArgumentErr if the rows of m do not all have the same length.
+func Shape[T any](m [][]T) (rows, cols int, err error) {
+	if len(m) == 0 {
+		return 0, 0, nil
+	}
+	cols = len(m[0])
+	for i, row := range m {
+		if len(row) != cols {
+			return 0, 0, fmt.Errorf("%w: row %d has %d columns but row 0 has %d", errors.BadArgumentErr, i, len(row), cols)
+		}
+	}
+	return len(m), cols, nil
+}
+
+// IsSquare tests whether m is rectangular with the same number of rows and columns.
+func IsSquare[T any](m [][]T) bool {
+	rows, cols, err := Shape(m)
+	return err == nil && rows == cols
+}
+
+func sameShape[T, U any](name string, a [][]T, b [][]U) (rows, cols int, err error) {
+	rows, cols, err = Shape(a)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: first matrix: %w", name, err)
+	}
+	rows2, cols2, err := Shape(b)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: second matrix: %w", name, err)
+	}
+	if rows != rows2 || cols != cols2 {
+		return 0, 0, fmt.Errorf("%w: %s(%dx%d, %dx%d): shapes do not match", errors.BadArgumentErr, name, rows, cols, rows2, cols2)
+	}
+	return rows, cols, nil
+}
+
+// Identity creates an n x n matrix with ones on the diagonal and zeros everywhere else.
+func Identity[T numeric](n int) [][]T {
+	output := list.ZeroCreate2D[T](n, n)
+	for i := range output {
+		output[i][i] = 1
+	}
+	return output
+}
+
+// Clone returns a copy of m that shares no memory with it.
+func Clone[T any](m [][]T) [][]T {
+	return list.Map2D(func(t T) T { return t }, m)
+}
+
+// Map applies mapping to each element of m. It is the same as list.Map2D.
+func Map[T, R any](mapping func(T) R, m [][]T) [][]R {
+	return list.Map2D(mapping, m)
+}
+
+// Zip combines the elements of a and b in the same positions with zipper.
+// It returns a BadArgumentErr if a and b do not have the same shape.
+func Zip[T, U, R any](zipper func(T, U) R, a [][]T, b [][]U) ([][]R, error) {
+	rows, cols, err := sameShape("Zip", a, b)
+	if err != nil {
+		return nil, err
+	}
+	output := list.ZeroCreate2D[R](rows, cols)
+	for i := range output {
+		for j := range output[i] {
+			output[i][j] = zipper(a[i][j], b[i][j])
+		}
+	}
+	return output, nil
+}
+
+// Add returns the element-wise sum of a and b.
+// It returns a BadArgumentErr if a and b do not have the same shape.
+func Add[T numeric](a, b [][]T) ([][]T, error) {
+	r, err := Zip(func(x, y T) T { return x + y }, a, b)
+	if err != nil {
+		return nil, fmt.Errorf("Add: %w", err)
+	}
+	return r, nil
+}
+
+// Sub returns the element-wise difference of a and b.
+// It returns a BadArgumentErr if a and b do not have the same shape.
+func Sub[T numeric](a, b [][]T) ([][]T, error) {
+	r, err := Zip(func(x, y T) T { return x - y }, a, b)
+	if err != nil {
+		return nil, fmt.Errorf("Sub: %w", err)
+	}
+	return r, nil
+}
+
+// Scale returns m with every element multiplied by k.
+func Scale[T numeric](k T, m [][]T) [][]T {
+	return list.Map2D(func(t T) T { return k * t }, m)
+}
+
+// Multiply returns the matrix product of a and b.
+// It returns a BadArgumentErr if the number of columns in a is not the number of rows in b.
+func Multiply[T numeric](a, b [][]T) ([][]T, error) {
+	rowsA, colsA, err := Shape(a)
+	if err != nil {
+		return nil, fmt.Errorf("Multiply: first matrix: %w", err)
+	}
+	rowsB, colsB, err := Shape(b)
+	if err != nil {
+		return nil, fmt.Errorf("Multiply: second matrix: %w", err)
+	}
+	if colsA != rowsB {
+		return nil, fmt.Errorf("%w: Multiply(%dx%d, %dx%d): columns of the first must equal rows of the second", errors.BadArgumentErr, rowsA, colsA, rowsB, colsB)
+	}
+	output := list.ZeroCreate2D[T](rowsA, colsB)
+	for i := range output {
+		for k := 0; k < colsA; k++ {
+			for j := range output[i] {
+				output[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return output, nil
+}
+
+// MultiplyVector returns the product of the matrix m and the column vector v.
+// It returns a BadArgumentErr if the number of columns in m is not the length of v.
+func MultiplyVector[T numeric](m [][]T, v []T) ([]T, error) {
+	rows, cols, err := Shape(m)
+	if err != nil {
+		return nil, fmt.Errorf("MultiplyVector: %w", err)
+	}
+	if cols != len(v) {
+		return nil, fmt.Errorf("%w: MultiplyVector(%dx%d, %d): columns of the matrix must equal the length of the vector", errors.BadArgumentErr, rows, cols, len(v))
+	}
+	output := make([]T, rows)
+	for i := range output {
+		for j, x := range v {
+			output[i] += m[i][j] * x
+		}
+	}
+	return output, nil
+}
+
+// Row returns a copy of row i of m.
+// It returns an IndexOutOfRangeErr if i is not a valid row index.
+func Row[T any](m [][]T, i int) ([]T, error) {
+	if i < 0 || i >= len(m) {
+		return nil, fmt.Errorf("%w: Row(%d) with %d rows", errors.IndexOutOfRangeErr, i, len(m))
+	}
+	return append([]T{}, m[i]...), nil
+}
+
+// Column returns a copy of column j of m.
+// It returns a BadArgumentErr if m is not rectangular or an IndexOutOfRangeErr if j is not a valid column index.
+func Column[T any](m [][]T, j int) ([]T, error) {
+	_, cols, err := Shape(m)
+	if err != nil {
+		return nil, fmt.Errorf("Column: %w", err)
+	}
+	if j < 0 || j >= cols {
+		return nil, fmt.Errorf("%w: Column(%d) with %d columns", errors.IndexOutOfRangeErr, j, cols)
+	}
+	return list.Map(func(row []T) T { return row[j] }, m), nil
+}
+
+// Slice returns a copy of the rows from rowStart up to but not including rowEnd
+// and the columns from colStart up to but not including colEnd.
+// It returns a BadArgumentErr if m is not rectangular or an IndexOutOfRangeErr if the ranges are not valid.
+func Slice[T any](m [][]T, rowStart, rowEnd, colStart, colEnd int) ([][]T, error) {
+	rows, cols, err := Shape(m)
+	if err != nil {
+		return nil, fmt.Errorf("Slice: %w", err)
+	}
+	if rowStart < 0 || rowEnd > rows || rowStart > rowEnd || colStart < 0 || colEnd > cols || colStart > colEnd {
+		return nil, fmt.Errorf("%w: Slice(%d, %d, %d, %d) of %dx%d", errors.IndexOutOfRangeErr, rowStart, rowEnd, colStart, colEnd, rows, cols)
+	}
+	return list.Map(func(row []T) []T { return append([]T{}, row[colStart:colEnd]...) }, m[rowStart:rowEnd]), nil
+}
@@ -0,0 +1,66 @@
+package matrix_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/matrix"
+)
+
+func ExampleShape() {
+	fmt.Println(matrix.Shape([][]int{{1, 2, 3}, {4, 5, 6}}))
+	fmt.Println(matrix.Shape([][]int{{1, 2, 3}, {4, 5}}))
+	// Output:
+	// 2 3 <nil>
+	// 0 0 bad argument: row 1 has 2 columns but row 0 has 3
+}
+
+func ExampleIdentity() {
+	fmt.Println(matrix.Identity[int](3))
+	// Output: [[1 0 0] [0 1 0] [0 0 1]]
+}
+
+func ExampleAdd() {
+	a := [][]int{{1, 2}, {3, 4}}
+	fmt.Println(matrix.Add(a, [][]int{{10, 20}, {30, 40}}))
+	fmt.Println(matrix.Sub(a, [][]int{{1, 1}}))
+	// Output:
+	// [[11 22] [33 44]] <nil>
+	// [] Sub: bad argument: Zip(2x2, 1x2): shapes do not match
+}
+
+func ExampleScale() {
+	fmt.Println(matrix.Scale(2.5, [][]float64{{1, 2}, {3, 4}}))
+	// Output: [[2.5 5] [7.5 10]]
+}
+
+func ExampleMultiply() {
+	a := [][]int{{1, 2, 3}, {4, 5, 6}}
+	b := [][]int{{7, 8}, {9, 10}, {11, 12}}
+	fmt.Println(matrix.Multiply(a, b))
+	fmt.Println(matrix.Multiply(a, a))
+	fmt.Println(matrix.MultiplyVector(a, []int{1, 0, -1}))
+	// Output:
+	// [[58 64] [139 154]] <nil>
+	// [] bad argument: Multiply(2x3, 2x3): columns of the first must equal rows of the second
+	// [-2 -2] <nil>
+}
+
+func ExampleZip() {
+	names := [][]string{{"a", "b"}, {"c", "d"}}
+	counts := [][]int{{1, 2}, {3, 4}}
+	fmt.Println(matrix.Zip(func(s string, n int) string { return fmt.Sprint(s, n) }, names, counts))
+	// Output: [[a1 b2] [c3 d4]] <nil>
+}
+
+func ExampleSlice() {
+	m := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	fmt.Println(matrix.Row(m, 1))
+	fmt.Println(matrix.Column(m, 2))
+	fmt.Println(matrix.Slice(m, 1, 3, 0, 2))
+	fmt.Println(matrix.Column(m, 3))
+	// Output:
+	// [4 5 6] <nil>
+	// [3 6 9] <nil>
+	// [[4 5] [7 8]] <nil>
+	// [] index out of range: Column(3) with 3 columns
+}
@@ -0,0 +1,43 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/flowonyx/functional/errors"
+)
+
+// Dot returns the dot product of a and b.
+// It returns a BadArgumentErr if a and b do not have the same length.
+func Dot[T numeric](a, b []T) (T, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("%w: Dot(%d, %d): vectors must have the same length", errors.BadArgumentErr, len(a), len(b))
+	}
+	var sum T
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// Cross returns the cross product of the three dimensional vectors a and b.
+// It returns a BadArgumentErr if either vector does not have a length of 3.
+func Cross[T numeric](a, b []T) ([]T, error) {
+	if len(a) != 3 || len(b) != 3 {
+		return nil, fmt.Errorf("%w: Cross(%d, %d): vectors must have a length of 3", errors.BadArgumentErr, len(a), len(b))
+	}
+	return []T{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}, nil
+}
+
+// Norm returns the Euclidean length of v.
+func Norm[T numeric](v []T) float64 {
+	sum := 0.0
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,25 @@
+package matrix_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/matrix"
+)
+
+func ExampleDot() {
+	fmt.Println(matrix.Dot([]int{1, 2, 3}, []int{4, 5, 6}))
+	fmt.Println(matrix.Dot([]int{1, 2, 3}, []int{4, 5}))
+	// Output:
+	// 32 <nil>
+	// 0 bad argument: Dot(3, 2): vectors must have the same length
+}
+
+func ExampleCross() {
+	fmt.Println(matrix.Cross([]int{1, 0, 0}, []int{0, 1, 0}))
+	// Output: [0 0 1] <nil>
+}
+
+func ExampleNorm() {
+	fmt.Println(matrix.Norm([]int{3, 4}))
+	// Output: 5
+}
@@ -1,5 +1,13 @@
 package functional
 
+import (
+	"reflect"
+	"strings"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
 // Must takes the output of a function that returns a value and an error,
 // panics if the error is not nil, or otherwise returns the value.
 func Must[T any](t T, err error) T {
@@ -44,3 +52,42 @@ func Must_2[T, R1, R2 any](f func(T) (R1, R2, error)) func(T) (R1, R2) {
 		return Must2(f(t))
 	}
 }
+
+// Must3 takes the output of a function that returns three values and an error,
+// panics if the error is not nil, or otherwise returns the values.
+func Must3[T1, T2, T3 any](t1 T1, t2 T2, t3 T3, err error) (T1, T2, T3) {
+	if err != nil {
+		panic(err)
+	}
+	return t1, t2, t3
+}
+
+// Must4 takes the output of a function that returns four values and an error,
+// panics if the error is not nil, or otherwise returns the values.
+func Must4[T1, T2, T3, T4 any](t1 T1, t2 T2, t3 T3, t4 T4, err error) (T1, T2, T3, T4) {
+	if err != nil {
+		panic(err)
+	}
+	return t1, t2, t3, t4
+}
+
+// Must5 takes the output of a function that returns five values and an error,
+// panics if the error is not nil, or otherwise returns the values.
+func Must5[T1, T2, T3, T4, T5 any](t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, err error) (T1, T2, T3, T4, T5) {
+	if err != nil {
+		panic(err)
+	}
+	return t1, t2, t3, t4, t5
+}
+
+// MustOption returns the value of o or panics if o is None.
+// The panic value is an error wrapping errors.NotFoundErr that names the type of the missing value
+// and includes msg if it is given.
+func MustOption[T any](o option.Option[T], msg ...string) T {
+	if o.IsNone() {
+		err := errors.NotFound("MustOption").With("type", reflect.TypeFor[T]().String())
+		err.Msg = strings.Join(msg, " ")
+		panic(err)
+	}
+	return o.Value()
+}
@@ -3,8 +3,6 @@
 package orderedMap
 
 import (
-	"fmt"
-
 	. "github.com/flowonyx/functional"
 	"github.com/flowonyx/functional/errors"
 	"github.com/flowonyx/functional/list"
@@ -57,8 +55,9 @@ func (m OrderedMap[Key, T]) ToSlice() []Pair[Key, T] {
 	return slices.Clone(m.pairs)
 }
 
-func (m OrderedMap[Key, T]) clone() OrderedMap[Key, T] {
-	return OrderedMap[Key, T]{pairs: slices.Clone(m.pairs)}
+// Clone returns a copy of the map that keeps the same order and does not share any memory with it.
+func (m OrderedMap[Key, T]) Clone() OrderedMap[Key, T] {
+	return OrderedMap[Key, T]{pairs: slices.Clone(m.pairs), less: m.less}
 }
 
 // Len returns the length of the map.
@@ -165,7 +164,7 @@ func (m OrderedMap[Key, T]) Find(key Key) (T, error) {
 	if index := m.indexOf(key); index >= 0 {
 		return m.pairs[index].Second, nil
 	}
-	return *(new(T)), fmt.Errorf("OrderedMap.Find(%v): %w", key, errors.KeyNotFoundErr)
+	return *(new(T)), errors.KeyNotFound("OrderedMap.Find", key)
 }
 
 // FindKey returns a key that matches the predicate or a KeyNotFoundErr error.
@@ -176,7 +175,7 @@ func (m OrderedMap[Key, T]) FindKey(predicate func(Key, T) bool) (Key, error) {
 			return key, nil
 		}
 	}
-	return *(new(Key)), fmt.Errorf("OrderedMap.FindKey: %w", errors.KeyNotFoundErr)
+	return *(new(Key)), errors.Wrap(errors.KeyNotFoundErr, "OrderedMap.FindKey")
 }
 
 // TryFindKey is the same as FindKey but it returns an Option with None if no key matches instead of returning an error.
@@ -293,7 +292,7 @@ func Pick[Key comparable, T, R any](chooser func(Key, T) option.Option[R], table
 			return v.Value(), nil
 		}
 	}
-	return *(new(R)), fmt.Errorf("Pick: %w", errors.KeyNotFoundErr)
+	return *(new(R)), errors.Wrap(errors.KeyNotFoundErr, "orderedMap.Pick")
 }
 
 // TryPick searches the map looking for the first element where the given function returns a Some value and returns the Some value. Returns None if no such element exists.
@@ -310,14 +309,14 @@ func TryPick[Key comparable, T, R any](chooser func(Key, T) option.Option[R], ta
 
 // Set returns a copy of table with the key set the value.
 func Set[Key comparable, T any](table OrderedMap[Key, T], key Key, value T) OrderedMap[Key, T] {
-	m := table.clone()
+	m := table.Clone()
 	m.Set(key, value)
 	return m
 }
 
 // Remove returns a copy of table with the key removed.
 func Remove[Key comparable, T any](table OrderedMap[Key, T], key Key) OrderedMap[Key, T] {
-	m := table.clone()
+	m := table.Clone()
 	m.Remove(key)
 	return m
 }
@@ -0,0 +1,215 @@
+package orderedMap
+
+import (
+	"sync"
+
+	. "github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/option"
+)
+
+// Sync is an OrderedMap that is safe for concurrent use.
+// Reads share a lock and writes take it exclusively. Functions that iterate over the map
+// work on a snapshot, so they do not hold the lock while calling back into your code
+// and it is safe to change the map from inside them.
+// A Sync must not be copied after it is first used.
+type Sync[Key comparable, T any] struct {
+	mu sync.RWMutex
+	m  OrderedMap[Key, T]
+}
+
+// NewSync creates a new Sync. If lessFunc is provided, it is used to keep the items in sorted order.
+// If lessFunc is not provided, the items are kept in the order in which they are added.
+func NewSync[Key comparable, T any](lessFunc ...func(Pair[Key, T], Pair[Key, T]) int) *Sync[Key, T] {
+	return &Sync[Key, T]{m: NewOrderedMap[Key, T](lessFunc...)}
+}
+
+// SyncFrom creates a Sync holding a copy of m.
+func SyncFrom[Key comparable, T any](m OrderedMap[Key, T]) *Sync[Key, T] {
+	return &Sync[Key, T]{m: m.Clone()}
+}
+
+// Snapshot returns a copy of the map as it is now.
+// Changes to the Sync after this do not affect the copy.
+func (s *Sync[Key, T]) Snapshot() OrderedMap[Key, T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Clone()
+}
+
+// Len returns the length of the map.
+func (s *Sync[Key, T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Len()
+}
+
+// Contains tests whether the given key is present in the map.
+func (s *Sync[Key, T]) Contains(key Key) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Contains(key)
+}
+
+// Get either gets the value associated with the key
+// or returns the zero value of the value type if the key is not present.
+func (s *Sync[Key, T]) Get(key Key) T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+// TryGet returns Some(value) if the key exists, otherwise it returns None.
+func (s *Sync[Key, T]) TryGet(key Key) option.Option[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.TryGet(key)
+}
+
+// Find is the same as Get except that it returns an error if the key is not found.
+func (s *Sync[Key, T]) Find(key Key) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Find(key)
+}
+
+// Set either adds the key and value to the map or
+// updates the value of the key that is already present.
+func (s *Sync[Key, T]) Set(key Key, value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Set(key, value)
+}
+
+// Remove removes a key from the map and returns the value it had, or None if it was not present.
+func (s *Sync[Key, T]) Remove(key Key) option.Option[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old := s.m.TryGet(key)
+	if old.IsSome() {
+		s.m.Remove(key)
+	}
+	return old
+}
+
+// GetOrAdd returns the value of the key if it is present.
+// Otherwise it adds value for the key and returns it.
+// The boolean is true if the value was already present.
+func (s *Sync[Key, T]) GetOrAdd(key Key, value T) (T, bool) {
+	return s.GetOrAddWith(key, func() T { return value })
+}
+
+// GetOrAddWith is the same as GetOrAdd except that the value is only created, by calling create,
+// if the key is not present. create is called while the map is locked, so it must not use the map.
+func (s *Sync[Key, T]) GetOrAddWith(key Key, create func() T) (T, bool) {
+	s.mu.RLock()
+	existing := s.m.TryGet(key)
+	s.mu.RUnlock()
+	if existing.IsSome() {
+		return existing.Value(), true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Another goroutine may have added the key between the two locks.
+	if existing := s.m.TryGet(key); existing.IsSome() {
+		return existing.Value(), true
+	}
+	value := create()
+	s.m.Set(key, value)
+	return value, false
+}
+
+// Update applies update to the current value of the key as one atomic step.
+// update receives Some(value) if the key is present or None if it is not.
+// If it returns Some, the key is set to the new value and if it returns None, the key is removed.
+// The result of update is returned.
+// update is called while the map is locked, so it must not use the map.
+func (s *Sync[Key, T]) Update(key Key, update func(option.Option[T]) option.Option[T]) option.Option[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	updated := update(s.m.TryGet(key))
+	if updated.IsSome() {
+		s.m.Set(key, updated.Value())
+	} else {
+		s.m.Remove(key)
+	}
+	return updated
+}
+
+// CompareAndSwapBy sets the key to new only if it is present and equal returns true for its current value and old.
+// It reports whether the value was swapped.
+func (s *Sync[Key, T]) CompareAndSwapBy(equal func(T, T) bool, key Key, old, new T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current := s.m.TryGet(key)
+	if current.IsNone() || !equal(current.Value(), old) {
+		return false
+	}
+	s.m.Set(key, new)
+	return true
+}
+
+// CompareAndDeleteBy removes the key only if it is present and equal returns true for its current value and old.
+// It reports whether the key was removed.
+func (s *Sync[Key, T]) CompareAndDeleteBy(equal func(T, T) bool, key Key, old T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current := s.m.TryGet(key)
+	if current.IsNone() || !equal(current.Value(), old) {
+		return false
+	}
+	s.m.Remove(key)
+	return true
+}
+
+// CompareAndSwap sets the key to new only if its current value is old.
+// It reports whether the value was swapped.
+func CompareAndSwap[Key, T comparable](s *Sync[Key, T], key Key, old, new T) bool {
+	return s.CompareAndSwapBy(func(a, b T) bool { return a == b }, key, old, new)
+}
+
+// CompareAndDelete removes the key only if its current value is old.
+// It reports whether the key was removed.
+func CompareAndDelete[Key, T comparable](s *Sync[Key, T], key Key, old T) bool {
+	return s.CompareAndDeleteBy(func(a, b T) bool { return a == b }, key, old)
+}
+
+// Keys returns all the keys in the map.
+func (s *Sync[Key, T]) Keys() []Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Keys()
+}
+
+// Values returns all the values in the map.
+func (s *Sync[Key, T]) Values() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Values()
+}
+
+// ToSlice exports the map as a slice of Key, Value Pairs.
+func (s *Sync[Key, T]) ToSlice() []Pair[Key, T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ToSlice()
+}
+
+// Iter applies the action to each key, value pair in a snapshot of the map.
+func (s *Sync[Key, T]) Iter(action func(Key, T)) {
+	s.Snapshot().Iter(action)
+}
+
+// Iteri applies the action to each key, value pair in a snapshot of the map, with the index as the first parameter to the action.
+func (s *Sync[Key, T]) Iteri(action func(int, Key, T)) {
+	s.Snapshot().Iteri(action)
+}
+
+// IterUntil applies the action to each key, value pair in a snapshot of the map until action returns true.
+func (s *Sync[Key, T]) IterUntil(action func(Key, T) bool) {
+	for _, p := range s.ToSlice() {
+		if action(p.First, p.Second) {
+			return
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package orderedMap
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/option"
+)
+
+func ExampleSync_GetOrAdd() {
+	m := NewSync[string, int]()
+	fmt.Println(m.GetOrAdd("a", 1))
+	fmt.Println(m.GetOrAdd("a", 2))
+	// Output:
+	// 1 false
+	// 1 true
+}
+
+func ExampleSync_Update() {
+	m := NewSync[string, int]()
+	increment := func(o option.Option[int]) option.Option[int] {
+		return option.Some(option.DefaultValue(0, o) + 1)
+	}
+	m.Update("hits", increment)
+	fmt.Println(m.Update("hits", increment))
+	m.Update("hits", func(option.Option[int]) option.Option[int] { return option.None[int]() })
+	fmt.Println(m.Contains("hits"))
+	// Output:
+	// Some(2)
+	// false
+}
+
+func ExampleCompareAndSwap() {
+	m := NewSync[string, string]()
+	m.Set("state", "idle")
+	fmt.Println(CompareAndSwap(m, "state", "running", "done"))
+	fmt.Println(CompareAndSwap(m, "state", "idle", "running"), m.Get("state"))
+	fmt.Println(CompareAndDelete(m, "state", "running"), m.Len())
+	// Output:
+	// false
+	// true running
+	// true 0
+}
+
+func ExampleSync_Iter() {
+	m := NewSync[int, string]()
+	m.Set(1, "one")
+	m.Set(2, "two")
+	// Iter works on a snapshot, so changing the map inside it does not deadlock.
+	m.Iter(func(k int, v string) {
+		m.Remove(k)
+		fmt.Println(k, v)
+	})
+	fmt.Println(m.Len())
+	// Output:
+	// 1 one
+	// 2 two
+	// 0
+}
+
+func ExampleSync_Snapshot() {
+	m := NewSync(func(a, b functional.Pair[string, int]) int { return a.Second - b.Second })
+	m.Set("b", 2)
+	m.Set("a", 1)
+	snapshot := m.Snapshot()
+	m.Set("c", 0)
+	fmt.Println(snapshot.Keys(), m.Keys())
+	// Output: [a b] [c a b]
+}
+
+func TestSyncConcurrentUpdate(t *testing.T) {
+	m := NewSync[int, int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Update(i%10, func(o option.Option[int]) option.Option[int] {
+					return option.Some(option.DefaultValue(0, o) + 1)
+				})
+				m.TryGet(i % 10)
+				m.Iter(func(int, int) {})
+			}
+		}()
+	}
+	wg.Wait()
+	if m.Len() != 10 {
+		t.Fatalf("Len() = %d, want 10", m.Len())
+	}
+	m.Iter(func(k, v int) {
+		if v != 80 {
+			t.Errorf("m[%d] = %d, want 80", k, v)
+		}
+	})
+}
+
+func TestSyncGetOrAddWithCreatesOnce(t *testing.T) {
+	m := NewSync[string, int]()
+	var mu sync.Mutex
+	created := 0
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.GetOrAddWith("key", func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				created++
+				return created
+			})
+		}()
+	}
+	wg.Wait()
+	if created != 1 || m.Get("key") != 1 {
+		t.Fatalf("created = %d, value = %d, want 1, 1", created, m.Get("key"))
+	}
+}
+
+func TestSyncCompareAndSwapCounter(t *testing.T) {
+	m := NewSync[string, int]()
+	m.Set("n", 0)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				for {
+					old := m.Get("n")
+					if CompareAndSwap(m, "n", old, old+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := m.Get("n"); n != 800 {
+		t.Fatalf("n = %d, want 800", n)
+	}
+}
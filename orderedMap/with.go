@@ -0,0 +1,153 @@
+package orderedMap
+
+import (
+	. "github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/eq"
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+	"golang.org/x/exp/slices"
+)
+
+// OrderedMapWith is a map-like structure like OrderedMap whose keys are compared with an eq.Equaler instead of ==,
+// so the keys can be types that are not comparable, such as slices, or match in other ways, such as strings without regard to case.
+// It must be created with NewOrderedMapWith or FromSliceWith.
+type OrderedMapWith[Key, T any] struct {
+	equaler eq.Equaler[Key]
+	pairs   []Pair[Key, T]
+	less    func(Pair[Key, T], Pair[Key, T]) int
+}
+
+// NewOrderedMapWith creates a new OrderedMapWith that uses equaler to compare keys.
+// If lessFunc is provided, it is used to keep the items in sorted order.
+// If lessFunc is not provided, the items are kept in the order in which they are added.
+func NewOrderedMapWith[Key, T any](equaler eq.Equaler[Key], lessFunc ...func(Pair[Key, T], Pair[Key, T]) int) OrderedMapWith[Key, T] {
+	var lf func(Pair[Key, T], Pair[Key, T]) int
+	if len(lessFunc) > 0 {
+		lf = lessFunc[0]
+	}
+	return OrderedMapWith[Key, T]{
+		equaler: equaler,
+		pairs:   make([]Pair[Key, T], 0),
+		less:    lf,
+	}
+}
+
+// FromSliceWith creates an OrderedMapWith that uses equaler to compare keys from a slice of Key, Value Pairs.
+// If lessFunc is provided, the items are sorted.
+// If a key is repeated, the first key is kept in its place with the last value for the key.
+func FromSliceWith[Key, T any](equaler eq.Equaler[Key], s []Pair[Key, T], lessFunc ...func(Pair[Key, T], Pair[Key, T]) int) OrderedMapWith[Key, T] {
+	m := NewOrderedMapWith(equaler, lessFunc...)
+	for _, p := range s {
+		m.Set(p.First, p.Second)
+	}
+	return m
+}
+
+// ToSlice exports the map as a slice of Key, Value Pairs.
+func (m OrderedMapWith[Key, T]) ToSlice() []Pair[Key, T] {
+	return slices.Clone(m.pairs)
+}
+
+// Clone returns a copy of the map that keeps the same order and does not share any memory with it.
+func (m OrderedMapWith[Key, T]) Clone() OrderedMapWith[Key, T] {
+	return OrderedMapWith[Key, T]{equaler: m.equaler, pairs: slices.Clone(m.pairs), less: m.less}
+}
+
+// Len returns the length of the map.
+func (m OrderedMapWith[Key, T]) Len() int {
+	return len(m.pairs)
+}
+
+// IsEmpty tests whether the map is empty.
+func (m OrderedMapWith[Key, T]) IsEmpty() bool {
+	return len(m.pairs) == 0
+}
+
+func (m OrderedMapWith[Key, T]) indexOf(key Key) int {
+	return list.IndexBy(func(p Pair[Key, T]) bool { return m.equaler.Equal(p.First, key) }, m.pairs)
+}
+
+// Contains tests whether a key equal to the given key is present in the map.
+func (m OrderedMapWith[Key, T]) Contains(key Key) bool {
+	return m.indexOf(key) >= 0
+}
+
+// Set either adds the key and value to the map or
+// updates the value of the equal key that is already present, keeping that key.
+func (m *OrderedMapWith[Key, T]) Set(key Key, value T) {
+	if index := m.indexOf(key); index >= 0 {
+		m.pairs[index].Second = value
+	} else {
+		m.pairs = append(m.pairs, PairOf(key, value))
+	}
+	if m.less != nil {
+		m.pairs = list.SortWith(m.less, m.pairs)
+	}
+}
+
+// Get either gets the value associated with the key
+// or returns the zero value of the value type if the
+// key is not present.
+func (m OrderedMapWith[Key, T]) Get(key Key) T {
+	return m.TryGet(key).Value()
+}
+
+// TryGet returns an optional value where if the key exists, it will be Some(value),
+// otherwise it will be None.
+func (m OrderedMapWith[Key, T]) TryGet(key Key) option.Option[T] {
+	if index := m.indexOf(key); index >= 0 {
+		return option.Some(m.pairs[index].Second)
+	}
+	return option.None[T]()
+}
+
+// Find is the same as Get except that it returns an error if the key is not found.
+func (m OrderedMapWith[Key, T]) Find(key Key) (T, error) {
+	if index := m.indexOf(key); index >= 0 {
+		return m.pairs[index].Second, nil
+	}
+	return *(new(T)), errors.KeyNotFound("OrderedMapWith.Find", key)
+}
+
+// Remove removes the key equal to the given key from the map.
+func (m *OrderedMapWith[Key, T]) Remove(key Key) {
+	if index := m.indexOf(key); index >= 0 {
+		m.pairs = slices.Delete(m.pairs, index, index+1)
+	}
+}
+
+// Filter returns a new OrderedMapWith with only the values that match the predicate.
+func (m OrderedMapWith[Key, T]) Filter(predicate func(Key, T) bool) OrderedMapWith[Key, T] {
+	return OrderedMapWith[Key, T]{
+		equaler: m.equaler,
+		pairs:   list.Filter(func(p Pair[Key, T]) bool { return predicate(p.First, p.Second) }, m.pairs...),
+		less:    m.less,
+	}
+}
+
+// Iter applies the action to each key, value pair in the map.
+func (m OrderedMapWith[Key, T]) Iter(action func(Key, T)) {
+	for _, p := range m.pairs {
+		action(p.First, p.Second)
+	}
+}
+
+// Keys returns all the keys in the map.
+func (m OrderedMapWith[Key, T]) Keys() []Key {
+	return list.Map(func(p Pair[Key, T]) Key { return p.First }, m.pairs)
+}
+
+// Values returns all the values in the map.
+func (m OrderedMapWith[Key, T]) Values() []T {
+	return list.Map(func(p Pair[Key, T]) T { return p.Second }, m.pairs)
+}
+
+// EqualWith tests whether two OrderedMapWiths hold the same keys with values that are equal by equaler.
+// Order is not considered.
+func EqualWith[Key, T any](equaler eq.Equaler[T], m, m2 OrderedMapWith[Key, T]) bool {
+	return m.Len() == m2.Len() && list.ForAll(func(p Pair[Key, T]) bool {
+		o := m2.TryGet(p.First)
+		return o.IsSome() && equaler.Equal(p.Second, o.Value())
+	}, m.pairs)
+}
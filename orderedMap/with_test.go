@@ -0,0 +1,38 @@
+package orderedMap
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/eq"
+)
+
+func ExampleNewOrderedMapWith() {
+	m := NewOrderedMapWith[string, int](eq.Folded[string]())
+	m.Set("Content-Type", 1)
+	m.Set("Accept", 2)
+	m.Set("content-type", 3)
+	fmt.Println(m.Keys(), m.Values(), m.Get("CONTENT-TYPE"), m.TryGet("Host"))
+	m.Remove("ACCEPT")
+	_, err := m.Find("accept")
+	fmt.Println(m.Len(), err)
+	// Output:
+	// [Content-Type Accept] [3 2] 3 None
+	// 1 key not found: OrderedMapWith.Find(key=accept)
+}
+
+func ExampleFromSliceWith() {
+	m := FromSliceWith(eq.Slice(eq.Integer[int]()), []functional.Pair[[]int, string]{
+		functional.PairOf([]int{1, 2}, "a"),
+		functional.PairOf([]int{3}, "b"),
+		functional.PairOf([]int{1, 2}, "c"),
+	})
+	fmt.Println(m.ToSlice())
+	fmt.Println(EqualWith(eq.String[string](), m, FromSliceWith(eq.Slice(eq.Integer[int]()), []functional.Pair[[]int, string]{
+		functional.PairOf([]int{3}, "b"),
+		functional.PairOf([]int{1, 2}, "c"),
+	})))
+	// Output:
+	// [([1 2], "c") ([3], "b")]
+	// true
+}
@@ -0,0 +1,62 @@
+package policy
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff returns how long to wait before the next attempt, given the number of attempts that have failed so far.
+type Backoff func(attempt int) time.Duration
+
+// Constant waits the same amount of time before every attempt.
+func Constant(d time.Duration) Backoff {
+	return func(int) time.Duration { return d }
+}
+
+// Exponential waits base before the second attempt and doubles the wait after each attempt that fails.
+// If limit is greater than 0, the wait never exceeds it.
+// Without a limit, a wait too long for a time.Duration is the longest time.Duration.
+// If base is 0 or less, it never waits.
+func Exponential(base, limit time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		if base <= 0 {
+			return 0
+		}
+		d := base
+		for i := 1; i < attempt; i++ {
+			if d > math.MaxInt64/2 {
+				// Doubling would overflow, so wait as long as allowed rather than not at all.
+				if limit > 0 {
+					return limit
+				}
+				return math.MaxInt64
+			}
+			d *= 2
+			if limit > 0 && d >= limit {
+				return limit
+			}
+		}
+		if limit > 0 && d > limit {
+			return limit
+		}
+		return d
+	}
+}
+
+// Jitter waits a random time between 0 and the wait returned from b ("full jitter"),
+// which keeps many callers from retrying at the same moment.
+// If r is nil, the global source is used. A *rand.Rand is not safe for concurrent use,
+// so only pass one if the policy is not shared between goroutines.
+func Jitter(r *rand.Rand, b Backoff) Backoff {
+	return func(attempt int) time.Duration {
+		d := b(attempt)
+		if d <= 0 {
+			return 0
+		}
+		if r == nil {
+			return time.Duration(rand.Int64N(int64(d) + 1))
+		}
+		return time.Duration(r.Int64N(int64(d) + 1))
+	}
+}
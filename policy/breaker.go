@@ -0,0 +1,246 @@
+package policy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+// State is the state of a Breaker.
+type State int
+
+const (
+	// Closed lets calls through and counts the failures.
+	Closed State = iota
+	// Open rejects calls with CircuitOpenErr until the reset timeout has passed.
+	Open
+	// HalfOpen lets a limited number of probe calls through to find out whether the failures have stopped.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+type breakerConfig struct {
+	failureThreshold int
+	resetTimeout     time.Duration
+	probes           int
+	trips            func(error) bool
+	onStateChange    func(from, to State)
+	clock            Clock
+}
+
+// BreakerOption is an option for NewBreaker.
+type BreakerOption func(*breakerConfig)
+
+// FailureThreshold sets the number of failures in a row that opens the circuit. The default is 5.
+func FailureThreshold(n int) BreakerOption {
+	return func(bc *breakerConfig) {
+		bc.failureThreshold = n
+	}
+}
+
+// ResetTimeout sets how long the circuit stays open before probe calls are let through. The default is 30 seconds.
+func ResetTimeout(d time.Duration) BreakerOption {
+	return func(bc *breakerConfig) {
+		bc.resetTimeout = d
+	}
+}
+
+// HalfOpenProbes sets the number of probe calls let through while half-open.
+// The circuit closes after that many succeed and opens again as soon as one fails. The default is 1.
+func HalfOpenProbes(n int) BreakerOption {
+	return func(bc *breakerConfig) {
+		bc.probes = n
+	}
+}
+
+// TripIf sets the predicate that decides whether an error counts as a failure.
+// An error that does not count is ignored: it neither counts as a failure nor as a success.
+// By default every error counts except context.Canceled.
+func TripIf(predicate func(error) bool) BreakerOption {
+	return func(bc *breakerConfig) {
+		bc.trips = predicate
+	}
+}
+
+// OnStateChange sets a function that is called whenever the state of the circuit changes.
+// It is called while the Breaker is locked, so it must not call the Breaker.
+func OnStateChange(action func(from, to State)) BreakerOption {
+	return func(bc *breakerConfig) {
+		bc.onStateChange = action
+	}
+}
+
+// BreakerClock sets the Clock used to time the reset timeout. The default is SystemClock.
+func BreakerClock(c Clock) BreakerOption {
+	return func(bc *breakerConfig) {
+		bc.clock = c
+	}
+}
+
+// Breaker holds the state of a circuit breaker. The same Breaker can be shared by the calls
+// to one dependency so they all stop calling it while it is failing.
+// It is safe for concurrent use.
+type Breaker struct {
+	config breakerConfig
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	openedAt  time.Time
+	inFlight  int
+	successes int
+	// generation changes with every change of state so late outcomes of earlier calls are ignored.
+	generation uint64
+}
+
+// NewBreaker creates a closed Breaker.
+func NewBreaker(opts ...BreakerOption) *Breaker {
+	config := breakerConfig{
+		failureThreshold: 5,
+		resetTimeout:     30 * time.Second,
+		probes:           1,
+		trips:            func(err error) bool { return !errors.Is(err, context.Canceled) },
+		clock:            SystemClock,
+	}
+	list.Iter(func(opt BreakerOption) { opt(&config) }, opts)
+	return &Breaker{config: config}
+}
+
+// State returns the current state of the circuit.
+// An open circuit whose reset timeout has passed is reported as HalfOpen.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.checkReset()
+	return b.state
+}
+
+func (b *Breaker) setState(s State) {
+	if s == b.state {
+		return
+	}
+	from := b.state
+	b.state = s
+	b.generation++
+	b.failures, b.inFlight, b.successes = 0, 0, 0
+	if s == Open {
+		b.openedAt = b.config.clock.Now()
+	}
+	if b.config.onStateChange != nil {
+		b.config.onStateChange(from, s)
+	}
+}
+
+func (b *Breaker) checkReset() {
+	if b.state == Open && !b.config.clock.Now().Before(b.openedAt.Add(b.config.resetTimeout)) {
+		b.setState(HalfOpen)
+	}
+}
+
+// allow reports whether a call can go ahead and, if it can, the generation it was let through in.
+func (b *Breaker) allow() (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.checkReset()
+	switch b.state {
+	case Open:
+		retryAfter := b.openedAt.Add(b.config.resetTimeout).Sub(b.config.clock.Now())
+		return 0, errors.Wrap(CircuitOpenErr, "CircuitBreaker").With("retryAfter", retryAfter)
+	case HalfOpen:
+		if b.inFlight >= b.config.probes {
+			return 0, errors.Wrap(CircuitOpenErr, "CircuitBreaker").With("state", HalfOpen)
+		}
+		b.inFlight++
+	}
+	return b.generation, nil
+}
+
+// outcome is what a call let through the Breaker says about the dependency.
+type outcome int
+
+const (
+	succeeded outcome = iota
+	failed
+	// ignored is an error that does not trip the circuit, which says nothing about the dependency either way.
+	ignored
+)
+
+func (b *Breaker) outcomeOf(err error) outcome {
+	switch {
+	case err == nil:
+		return succeeded
+	case b.config.trips(err):
+		return failed
+	}
+	return ignored
+}
+
+func (b *Breaker) record(generation uint64, o outcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.generation != generation {
+		return
+	}
+	switch b.state {
+	case Closed:
+		switch o {
+		case succeeded:
+			b.failures = 0
+		case failed:
+			b.failures++
+			if b.failures >= b.config.failureThreshold {
+				b.setState(Open)
+			}
+		}
+	case HalfOpen:
+		b.inFlight--
+		switch o {
+		case succeeded:
+			b.successes++
+			if b.successes >= b.config.probes {
+				b.setState(Closed)
+			}
+		case failed:
+			b.setState(Open)
+		}
+	}
+}
+
+// CircuitBreaker stops making the call while b is open and returns an error wrapping CircuitOpenErr instead.
+// A call that panics counts as a failure before the panic carries on.
+func CircuitBreaker[T any](b *Breaker) Policy[T] {
+	return func(f Func[T]) Func[T] {
+		return func(ctx context.Context) (T, error) {
+			generation, err := b.allow()
+			if err != nil {
+				var zero T
+				return zero, err
+			}
+			// A call that panics counts as a failure, and its probe slot is freed so the circuit is not stuck half-open.
+			returned := false
+			defer func() {
+				if !returned {
+					b.record(generation, failed)
+				}
+			}()
+			v, err := f(ctx)
+			returned = true
+			b.record(generation, b.outcomeOf(err))
+			return v, err
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/policy"
+)
+
+func ExampleCircuitBreaker() {
+	clock := policy.NewManualClock(start)
+	b := policy.NewBreaker(
+		policy.FailureThreshold(2),
+		policy.ResetTimeout(time.Minute),
+		policy.BreakerClock(clock),
+		policy.OnStateChange(func(from, to policy.State) { fmt.Println(from, "->", to) }),
+	)
+	failing := true
+	f := policy.Wrap(func(context.Context) (string, error) {
+		if failing {
+			return "", errors.New("down")
+		}
+		return "up", nil
+	}, policy.CircuitBreaker[string](b))
+
+	for i := 0; i < 3; i++ {
+		_, err := f(context.Background())
+		fmt.Println(err)
+	}
+
+	clock.Advance(time.Minute)
+	fmt.Println(b.State())
+	_, err := f(context.Background())
+	fmt.Println(err)
+
+	clock.Advance(time.Minute)
+	failing = false
+	fmt.Println(f(context.Background()))
+	// Output:
+	// down
+	// closed -> open
+	// down
+	// circuit open: CircuitBreaker(retryAfter=1m0s)
+	// open -> half-open
+	// half-open
+	// half-open -> open
+	// down
+	// open -> half-open
+	// half-open -> closed
+	// up <nil>
+}
+
+func ExampleHalfOpenProbes() {
+	clock := policy.NewManualClock(start)
+	b := policy.NewBreaker(policy.FailureThreshold(1), policy.HalfOpenProbes(2), policy.BreakerClock(clock))
+	fail := policy.Wrap(policy.Lift(func() (int, error) { return 0, errors.New("down") }), policy.CircuitBreaker[int](b))
+	succeed := policy.Wrap(policy.Lift(func() (int, error) { return 1, nil }), policy.CircuitBreaker[int](b))
+
+	fail(context.Background())
+	clock.Advance(time.Hour)
+	succeed(context.Background())
+	fmt.Println(b.State())
+	succeed(context.Background())
+	fmt.Println(b.State())
+	// Output:
+	// half-open
+	// closed
+}
+
+func ExampleTripIf() {
+	clock := policy.NewManualClock(start)
+	b := policy.NewBreaker(policy.FailureThreshold(2), policy.BreakerClock(clock))
+	fail := policy.Wrap(policy.Lift(func() (int, error) { return 0, errors.New("down") }), policy.CircuitBreaker[int](b))
+	cancel := policy.Wrap(policy.Lift(func() (int, error) { return 0, context.Canceled }), policy.CircuitBreaker[int](b))
+
+	// A canceled call does not reset the failures in a row.
+	fail(context.Background())
+	cancel(context.Background())
+	fail(context.Background())
+	fmt.Println(b.State())
+
+	// Nor does a canceled probe close the circuit, but it frees the probe for the next call.
+	clock.Advance(time.Minute)
+	cancel(context.Background())
+	fmt.Println(b.State())
+	_, err := fail(context.Background())
+	fmt.Println(err, b.State())
+	// Output:
+	// open
+	// half-open
+	// down open
+}
+
+func TestCircuitBreakerPanic(t *testing.T) {
+	clock := policy.NewManualClock(start)
+	b := policy.NewBreaker(policy.FailureThreshold(1), policy.BreakerClock(clock))
+	panics := policy.Wrap(policy.Lift(func() (int, error) { panic("boom") }), policy.CircuitBreaker[int](b))
+	succeed := policy.Wrap(policy.Lift(func() (int, error) { return 1, nil }), policy.CircuitBreaker[int](b))
+	call := func() (recovered any) {
+		defer func() { recovered = recover() }()
+		panics(context.Background())
+		return nil
+	}
+
+	if r := call(); r != "boom" {
+		t.Fatalf("the panic was not passed on: %v", r)
+	}
+	if s := b.State(); s != policy.Open {
+		t.Fatalf("a panic in a closed circuit did not count as a failure: %v", s)
+	}
+	clock.Advance(time.Hour)
+	call()
+	if s := b.State(); s != policy.Open {
+		t.Fatalf("a panicking probe did not open the circuit: %v", s)
+	}
+	clock.Advance(time.Hour)
+	if _, err := succeed(context.Background()); err != nil || b.State() != policy.Closed {
+		t.Fatalf("the circuit did not close after a panicking probe: %v %v", err, b.State())
+	}
+}
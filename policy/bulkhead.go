@@ -0,0 +1,86 @@
+package policy
+
+import (
+	"context"
+	"sync"
+
+	"github.com/flowonyx/functional/errors"
+)
+
+// Limiter limits the number of calls that run at the same time.
+// The same Limiter can be shared by the calls to one dependency so that a slow dependency
+// cannot use up every goroutine. It is safe for concurrent use.
+type Limiter struct {
+	slots      chan struct{}
+	maxWaiting int
+
+	mu      sync.Mutex
+	waiting int
+}
+
+// NewLimiter creates a Limiter that lets maxConcurrent calls run at once. A maxConcurrent less than 1 is treated as 1.
+// By default any number of calls may wait for a slot. If maxWaiting is given,
+// calls beyond that are rejected immediately with an error wrapping BulkheadFullErr.
+func NewLimiter(maxConcurrent int, maxWaiting ...int) *Limiter {
+	l := &Limiter{slots: make(chan struct{}, max(maxConcurrent, 1)), maxWaiting: -1}
+	if len(maxWaiting) > 0 {
+		l.maxWaiting = maxWaiting[0]
+	}
+	return l
+}
+
+// Running returns the number of calls that are running.
+func (l *Limiter) Running() int {
+	return len(l.slots)
+}
+
+// Waiting returns the number of calls that are waiting for a slot.
+func (l *Limiter) Waiting() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.waiting
+}
+
+func (l *Limiter) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+	l.mu.Lock()
+	if l.maxWaiting >= 0 && l.waiting >= l.maxWaiting {
+		l.mu.Unlock()
+		return errors.Wrap(BulkheadFullErr, "Bulkhead").With("running", cap(l.slots)).With("waiting", l.maxWaiting)
+	}
+	l.waiting++
+	l.mu.Unlock()
+	defer func() {
+		l.mu.Lock()
+		l.waiting--
+		l.mu.Unlock()
+	}()
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *Limiter) release() {
+	<-l.slots
+}
+
+// Bulkhead runs the call only when l has a free slot, waiting for one if necessary.
+func Bulkhead[T any](l *Limiter) Policy[T] {
+	return func(f Func[T]) Func[T] {
+		return func(ctx context.Context) (T, error) {
+			if err := l.acquire(ctx); err != nil {
+				var zero T
+				return zero, err
+			}
+			defer l.release()
+			return f(ctx)
+		}
+	}
+}
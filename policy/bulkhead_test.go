@@ -0,0 +1,104 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/policy"
+)
+
+func ExampleBulkhead() {
+	l := policy.NewLimiter(1, 0)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	f := policy.Wrap(func(context.Context) (int, error) {
+		close(started)
+		<-release
+		return 1, nil
+	}, policy.Bulkhead[int](l))
+
+	done := make(chan int)
+	go func() {
+		v, _ := f(context.Background())
+		done <- v
+	}()
+	<-started
+	_, err := f(context.Background())
+	fmt.Println(err, errors.Is(err, policy.BulkheadFullErr))
+	close(release)
+	fmt.Println(<-done)
+	// Output:
+	// bulkhead full: Bulkhead(running=1, waiting=0) true
+	// 1
+}
+
+func TestBulkheadLimitsConcurrency(t *testing.T) {
+	l := policy.NewLimiter(3)
+	var mu sync.Mutex
+	running, peak := 0, 0
+	f := policy.Wrap(func(context.Context) (int, error) {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return 0, nil
+	}, policy.Bulkhead[int](l))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := f(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if peak > 3 {
+		t.Errorf("peak concurrency was %d, want at most 3", peak)
+	}
+	if l.Running() != 0 || l.Waiting() != 0 {
+		t.Errorf("Running() = %d, Waiting() = %d, want 0, 0", l.Running(), l.Waiting())
+	}
+}
+
+func TestBulkheadWaitCanceled(t *testing.T) {
+	l := policy.NewLimiter(1)
+	block := make(chan struct{})
+	started := make(chan struct{})
+	f := policy.Wrap(func(context.Context) (int, error) {
+		close(started)
+		<-block
+		return 0, nil
+	}, policy.Bulkhead[int](l))
+	go f(context.Background())
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	g := policy.Wrap(policy.Lift(func() (int, error) { return 0, nil }), policy.Bulkhead[int](l))
+	if _, err := g(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+	close(block)
+}
+
+func TestNewLimiterBelowOne(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		f := policy.Wrap(policy.Lift(func() (int, error) { return 1, nil }), policy.Bulkhead[int](policy.NewLimiter(n)))
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		if v, err := f(ctx); v != 1 || err != nil {
+			t.Errorf("NewLimiter(%d) did not let a call run: %v %v", n, v, err)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,90 @@
+package policy
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time used by the policies so tests can control it.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the Clock used when no other is given. It uses the time package.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// ManualClock is a Clock that only moves when it is told to.
+// It is safe for concurrent use.
+type ManualClock struct {
+	// AutoAdvance makes After move the clock forward by the duration and return immediately,
+	// so waits take no real time.
+	AutoAdvance bool
+
+	mu      sync.Mutex
+	now     time.Time
+	waiters []waiter
+}
+
+type waiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewManualClock creates a ManualClock that starts at start.
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now returns the current time of the clock.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the time once the clock has been advanced by d.
+func (c *ManualClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	c.mu.Lock()
+	at := c.now.Add(d)
+	if c.AutoAdvance && d > 0 {
+		c.now = at
+	}
+	if !at.After(c.now) {
+		ch <- c.now
+	} else {
+		c.waiters = append(c.waiters, waiter{at: at, ch: ch})
+	}
+	c.mu.Unlock()
+	return ch
+}
+
+// Advance moves the clock forward by d and fires any channels returned by After that are due.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = pending
+}
+
+// Waiters returns the number of channels returned by After that have not fired yet.
+// Tests can use it to wait until a policy is blocked on the clock.
+func (c *ManualClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
@@ -0,0 +1,21 @@
+package policy
+
+import "context"
+
+// Fallback calls fallback with the error when the call fails and returns its value instead.
+func Fallback[T any](fallback func(context.Context, error) (T, error)) Policy[T] {
+	return func(f Func[T]) Func[T] {
+		return func(ctx context.Context) (T, error) {
+			v, err := f(ctx)
+			if err != nil {
+				return fallback(ctx, err)
+			}
+			return v, nil
+		}
+	}
+}
+
+// FallbackValue returns value when the call fails.
+func FallbackValue[T any](value T) Policy[T] {
+	return Fallback(func(context.Context, error) (T, error) { return value, nil })
+}
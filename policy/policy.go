@@ -0,0 +1,49 @@
+// Package policy provides resilience policies, such as retries and circuit breakers,
+// that wrap calls which can fail and can be composed together.
+package policy
+
+import (
+	"context"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/result"
+)
+
+// Errors returned by the policies. They are wrapped in an errors.Error, so test for them with errors.Is.
+const (
+	RetriesExhaustedErr = errors.FunctionalError("retries exhausted")
+	TimeoutErr          = errors.FunctionalError("timeout")
+	CircuitOpenErr      = errors.FunctionalError("circuit open")
+	BulkheadFullErr     = errors.FunctionalError("bulkhead full")
+)
+
+// Func is a call that can be wrapped by a Policy.
+type Func[T any] func(context.Context) (T, error)
+
+// Policy wraps a Func with extra behavior, such as retrying it when it fails.
+type Policy[T any] func(Func[T]) Func[T]
+
+// Lift adapts a function that returns a value and an error into a Func that ignores the context.
+func Lift[T any](f func() (T, error)) Func[T] {
+	return func(context.Context) (T, error) {
+		return f()
+	}
+}
+
+// Wrap applies policies to f. The first policy is the outermost,
+// so Wrap(f, Fallback(...), Retry(...)) falls back only after all the retries fail.
+func Wrap[T any](f Func[T], policies ...Policy[T]) Func[T] {
+	for i := len(policies) - 1; i >= 0; i-- {
+		f = policies[i](f)
+	}
+	return f
+}
+
+// Run calls f wrapped in policies and returns the value as a Result.
+func Run[T any](ctx context.Context, f Func[T], policies ...Policy[T]) result.Result[T, error] {
+	v, err := Wrap(f, policies...)(ctx)
+	if err != nil {
+		return result.Failure[T](err)
+	}
+	return result.Success[T, error](v)
+}
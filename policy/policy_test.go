@@ -0,0 +1,72 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/policy"
+)
+
+func ExampleTimeout() {
+	clock := policy.NewManualClock(start)
+	clock.AutoAdvance = true
+	slow := func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	r := policy.Run(context.Background(), slow, policy.Timeout[int](time.Second, clock))
+	fmt.Println(r.FailureValue(), errors.Is(r.FailureValue(), policy.TimeoutErr))
+
+	// The manual clock never fires unless it is advanced, so the call always wins.
+	fast := policy.Lift(func() (int, error) { return 1, nil })
+	fmt.Println(policy.Run(context.Background(), fast, policy.Timeout[int](time.Second, policy.NewManualClock(start))).SuccessValue())
+	// Output:
+	// timeout: Timeout(after=1s) true
+	// 1
+}
+
+func TestTimeoutPanic(t *testing.T) {
+	b := policy.NewBreaker(policy.FailureThreshold(1), policy.BreakerClock(policy.NewManualClock(start)))
+	f := policy.Lift(func() (int, error) { panic("boom") })
+	call := policy.Wrap(f, policy.CircuitBreaker[int](b), policy.Timeout[int](time.Second, policy.NewManualClock(start)))
+	recovered := func() (recovered any) {
+		defer func() { recovered = recover() }()
+		call(context.Background())
+		return nil
+	}()
+	if recovered != "boom" {
+		t.Fatalf("the panic was not passed on to the caller: %v", recovered)
+	}
+	if s := b.State(); s != policy.Open {
+		t.Fatalf("the circuit breaker did not count the panic as a failure: %v", s)
+	}
+}
+
+func ExampleFallbackValue() {
+	f := policy.Lift(func() (string, error) { return "", errors.New("unavailable") })
+	r := policy.Run(context.Background(), f, policy.FallbackValue("cached"))
+	fmt.Println(r.SuccessValue())
+	// Output: cached
+}
+
+func ExampleWrap() {
+	clock := policy.NewManualClock(start)
+	clock.AutoAdvance = true
+	calls := 0
+	f := func(context.Context) (string, error) {
+		calls++
+		return "", errors.New("unavailable")
+	}
+	wrapped := policy.Wrap(f,
+		policy.Fallback(func(_ context.Context, err error) (string, error) {
+			return "fallback after " + err.Error(), nil
+		}),
+		policy.Retry[string](policy.MaxAttempts(2), policy.RetryClock(clock)),
+	)
+	v, err := wrapped(context.Background())
+	fmt.Println(v, err, calls)
+	// Output: fallback after retries exhausted: Retry(attempts=2): unavailable <nil> 2
+}
@@ -0,0 +1,102 @@
+package policy
+
+import (
+	"context"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+type retryConfig struct {
+	maxAttempts int
+	backoff     Backoff
+	retryable   func(error) bool
+	onRetry     func(attempt int, err error, wait time.Duration)
+	clock       Clock
+}
+
+// RetryOption is an option for Retry.
+type RetryOption func(*retryConfig)
+
+// MaxAttempts sets the number of times the call is made before giving up, including the first. The default is 3.
+func MaxAttempts(n int) RetryOption {
+	return func(rc *retryConfig) {
+		rc.maxAttempts = n
+	}
+}
+
+// WithBackoff sets how long to wait between attempts. The default is Exponential(100*time.Millisecond, 10*time.Second).
+func WithBackoff(b Backoff) RetryOption {
+	return func(rc *retryConfig) {
+		rc.backoff = b
+	}
+}
+
+// RetryIf sets the predicate that decides whether an error is worth retrying.
+// By default every error is retried except context.Canceled and context.DeadlineExceeded.
+func RetryIf(predicate func(error) bool) RetryOption {
+	return func(rc *retryConfig) {
+		rc.retryable = predicate
+	}
+}
+
+// OnRetry sets a function that is called after each failed attempt that will be retried,
+// with the number of the attempt that failed, its error and how long Retry will wait.
+func OnRetry(action func(attempt int, err error, wait time.Duration)) RetryOption {
+	return func(rc *retryConfig) {
+		rc.onRetry = action
+	}
+}
+
+// RetryClock sets the Clock used to wait between attempts. The default is SystemClock.
+func RetryClock(c Clock) RetryOption {
+	return func(rc *retryConfig) {
+		rc.clock = c
+	}
+}
+
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Retry makes the call again when it fails, waiting between attempts as set by WithBackoff.
+// An error that is not retryable is returned as it is. When every attempt fails,
+// the error wraps RetriesExhaustedErr and the error from the last attempt.
+// Waiting stops early if the context is done.
+func Retry[T any](opts ...RetryOption) Policy[T] {
+	config := retryConfig{
+		maxAttempts: 3,
+		backoff:     Exponential(100*time.Millisecond, 10*time.Second),
+		retryable:   func(err error) bool { return !isContextErr(err) },
+		clock:       SystemClock,
+	}
+	list.Iter(func(opt RetryOption) { opt(&config) }, opts)
+
+	return func(f Func[T]) Func[T] {
+		return func(ctx context.Context) (T, error) {
+			var zero T
+			for attempt := 1; ; attempt++ {
+				v, err := f(ctx)
+				if err == nil {
+					return v, nil
+				}
+				if !config.retryable(err) {
+					return zero, err
+				}
+				if attempt >= config.maxAttempts {
+					return zero, errors.Wrap(RetriesExhaustedErr, "Retry").With("attempts", attempt).Because(err)
+				}
+				wait := config.backoff(attempt)
+				if config.onRetry != nil {
+					config.onRetry(attempt, err, wait)
+				}
+				select {
+				case <-ctx.Done():
+					return zero, errors.Join(err, ctx.Err())
+				case <-config.clock.After(wait):
+				}
+			}
+		}
+	}
+}
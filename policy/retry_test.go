@@ -0,0 +1,114 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/policy"
+)
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// flaky returns a call that fails the given number of times before it succeeds.
+func flaky(failures int) (policy.Func[string], *int) {
+	calls := 0
+	return func(context.Context) (string, error) {
+		calls++
+		if calls <= failures {
+			return "", fmt.Errorf("attempt %d failed", calls)
+		}
+		return "ok", nil
+	}, &calls
+}
+
+func ExampleRetry() {
+	clock := policy.NewManualClock(start)
+	clock.AutoAdvance = true
+	f, calls := flaky(2)
+	r := policy.Run(context.Background(), f, policy.Retry[string](
+		policy.MaxAttempts(5),
+		policy.WithBackoff(policy.Exponential(time.Second, time.Minute)),
+		policy.RetryClock(clock),
+		policy.OnRetry(func(attempt int, err error, wait time.Duration) {
+			fmt.Println(err, "- waiting", wait)
+		}),
+	))
+	fmt.Println(r.SuccessValue(), *calls, clock.Now().Sub(start))
+	// Output:
+	// attempt 1 failed - waiting 1s
+	// attempt 2 failed - waiting 2s
+	// ok 3 3s
+}
+
+func ExampleRetry_exhausted() {
+	clock := policy.NewManualClock(start)
+	clock.AutoAdvance = true
+	f, calls := flaky(10)
+	r := policy.Run(context.Background(), f, policy.Retry[string](policy.RetryClock(clock)))
+	attempts, _ := errors.FieldOf[int](r.FailureValue(), "attempts")
+	fmt.Println(r.FailureValue())
+	fmt.Println(errors.Is(r.FailureValue(), policy.RetriesExhaustedErr), attempts, *calls)
+	// Output:
+	// retries exhausted: Retry(attempts=3): attempt 3 failed
+	// true 3 3
+}
+
+func ExampleRetryIf() {
+	permanent := errors.New("permanent")
+	calls := 0
+	f := func(context.Context) (int, error) {
+		calls++
+		return 0, permanent
+	}
+	r := policy.Run(context.Background(), f, policy.Retry[int](
+		policy.RetryIf(func(err error) bool { return !errors.Is(err, permanent) }),
+	))
+	fmt.Println(r.FailureValue(), calls)
+	// Output: permanent 1
+}
+
+func ExampleExponential() {
+	b := policy.Exponential(100*time.Millisecond, time.Second)
+	fmt.Println(b(1), b(2), b(3), b(4), b(5), b(100))
+	// Output: 100ms 200ms 400ms 800ms 1s 1s
+}
+
+func TestExponentialOverflow(t *testing.T) {
+	b := policy.Exponential(time.Second, 0)
+	for _, attempt := range []int{40, 64, 100, 1000} {
+		if d := b(attempt); d != time.Duration(math.MaxInt64) {
+			t.Errorf("attempt %d: got %v, want the longest time.Duration", attempt, d)
+		}
+	}
+	if d := policy.Exponential(time.Second, time.Hour)(1000); d != time.Hour {
+		t.Errorf("got %v, want the limit", d)
+	}
+}
+
+func TestExponentialNonPositiveBase(t *testing.T) {
+	for _, base := range []time.Duration{0, -time.Second} {
+		for _, limit := range []time.Duration{0, time.Hour} {
+			b := policy.Exponential(base, limit)
+			for _, attempt := range []int{1, 2, 10, 100} {
+				if d := b(attempt); d != 0 {
+					t.Errorf("Exponential(%v, %v)(%d) = %v, want 0", base, limit, attempt, d)
+				}
+			}
+		}
+	}
+}
+
+func ExampleJitter() {
+	b := policy.Jitter(nil, policy.Constant(time.Second))
+	ok := true
+	for i := 1; i < 100; i++ {
+		d := b(i)
+		ok = ok && d >= 0 && d <= time.Second
+	}
+	fmt.Println(ok)
+	// Output: true
+}
@@ -0,0 +1,57 @@
+package policy
+
+import (
+	"context"
+	"time"
+
+	"github.com/flowonyx/functional/errors"
+)
+
+// Timeout returns a TimeoutErr if the call does not finish within d.
+// The context passed to the call is canceled when the time is up, but the call
+// keeps running in its goroutine until it returns, so it should watch the context.
+// If the call panics before the time is up, the panic is passed on in the caller's goroutine
+// so that CircuitBreaker and functional.Try see it; a panic after the time is up is dropped.
+// An optional Clock can be given for tests. The default is SystemClock.
+func Timeout[T any](d time.Duration, clock ...Clock) Policy[T] {
+	c := SystemClock
+	if len(clock) > 0 {
+		c = clock[0]
+	}
+	type outcome struct {
+		v        T
+		err      error
+		panicked bool
+		p        any
+	}
+	return func(f Func[T]) Func[T] {
+		return func(ctx context.Context) (T, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			done := make(chan outcome, 1)
+			go func() {
+				o := outcome{panicked: true}
+				defer func() {
+					if o.panicked {
+						o.p = recover()
+					}
+					done <- o
+				}()
+				o.v, o.err = f(ctx)
+				o.panicked = false
+			}()
+			var zero T
+			select {
+			case o := <-done:
+				if o.panicked {
+					panic(o.p)
+				}
+				return o.v, o.err
+			case <-ctx.Done():
+				return zero, ctx.Err()
+			case <-c.After(d):
+				return zero, errors.Wrap(TimeoutErr, "Timeout").With("after", d)
+			}
+		}
+	}
+}
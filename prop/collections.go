@@ -0,0 +1,165 @@
+package prop
+
+import (
+	"math/rand/v2"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+	"github.com/flowonyx/functional/orderedMap"
+	"github.com/flowonyx/functional/result"
+	"github.com/flowonyx/functional/set"
+)
+
+// defaultRunes mixes ASCII with multi-byte characters to catch code that confuses bytes and runes.
+var defaultRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 _-.,;:!?'\"/\\\t\néßøЖ世界😀")
+
+// Rune creates a Gen of runes from a mix of ASCII letters, digits, punctuation, whitespace and
+// multi-byte characters, shrinking toward 'a'.
+func Rune() Gen[rune] {
+	return OneOf(defaultRunes...)
+}
+
+// RuneOf creates a Gen of the runes in chars, shrinking toward the first.
+func RuneOf(chars string) Gen[rune] {
+	return OneOf([]rune(chars)...)
+}
+
+// String creates a Gen of strings made from Rune, shrinking toward shorter strings of 'a'.
+func String() Gen[string] {
+	return StringOf(Rune())
+}
+
+// StringOf creates a Gen of strings made from the runes generated by g.
+func StringOf(g Gen[rune]) Gen[string] {
+	return Map(func(runes []rune) string { return string(runes) }, SliceOf(g))
+}
+
+// SliceOf creates a Gen of slices with items generated by g and a length up to the size of the run.
+// Slices shrink by removing items and then by shrinking the remaining items.
+func SliceOf[T any](g Gen[T]) Gen[[]T] {
+	return Gen[[]T]{generate: func(r *rand.Rand, size int) tree[[]T] {
+		return generateSlice(r, size, r.IntN(size+1), 0, g)
+	}}
+}
+
+// SliceOfN creates a Gen of slices with items generated by g and a length from minLen to maxLen (inclusive).
+// It panics if minLen is negative or greater than maxLen.
+func SliceOfN[T any](minLen, maxLen int, g Gen[T]) Gen[[]T] {
+	if minLen < 0 || minLen > maxLen {
+		panic("prop.SliceOfN: invalid length range")
+	}
+	return Gen[[]T]{generate: func(r *rand.Rand, size int) tree[[]T] {
+		return generateSlice(r, size, minLen+r.IntN(maxLen-minLen+1), minLen, g)
+	}}
+}
+
+func generateSlice[T any](r *rand.Rand, size, length, minLen int, g Gen[T]) tree[[]T] {
+	items := make([]tree[T], length)
+	for i := range items {
+		items[i] = g.generate(r, size)
+	}
+	return sliceTree(items, minLen)
+}
+
+func sliceTree[T any](items []tree[T], minLen int) tree[[]T] {
+	return tree[[]T]{
+		value: list.Map(func(t tree[T]) T { return t.value }, items),
+		shrink: func() []tree[[]T] {
+			shrinks := []tree[[]T]{}
+			// remove chunks, starting with as many items as allowed and halving each time
+			for chunk := len(items) - minLen; chunk > 0; chunk /= 2 {
+				for start := 0; start+chunk <= len(items); start += chunk {
+					rest := list.Concat(items[:start], items[start+chunk:])
+					shrinks = append(shrinks, sliceTree(rest, minLen))
+				}
+			}
+			for i := range items {
+				for _, c := range items[i].shrink() {
+					replaced := append([]tree[T]{}, items...)
+					replaced[i] = c
+					shrinks = append(shrinks, sliceTree(replaced, minLen))
+				}
+			}
+			return shrinks
+		},
+	}
+}
+
+// MapOf creates a Gen of maps with keys generated by keys and values generated by values.
+func MapOf[Key comparable, T any](keys Gen[Key], values Gen[T]) Gen[map[Key]T] {
+	return Map(func(pairs []functional.Pair[Key, T]) map[Key]T {
+		m := make(map[Key]T, len(pairs))
+		for _, p := range pairs {
+			m[p.First] = p.Second
+		}
+		return m
+	}, SliceOf(PairOf(keys, values)))
+}
+
+// SetOf creates a Gen of set.Sets with items generated by g.
+// If lessFunc is provided, it is passed to set.FromSlice to keep the items sorted.
+func SetOf[T comparable](g Gen[T], lessFunc ...func(T, T) int) Gen[set.Set[T]] {
+	return Map(func(items []T) set.Set[T] { return set.FromSlice(items, lessFunc...) }, SliceOf(g))
+}
+
+// OrderedMapOf creates a Gen of orderedMap.OrderedMaps with keys generated by keys and values generated by values.
+// If lessFunc is provided, it is passed to orderedMap.FromSlice to keep the items sorted.
+func OrderedMapOf[Key comparable, T any](keys Gen[Key], values Gen[T], lessFunc ...func(functional.Pair[Key, T], functional.Pair[Key, T]) int) Gen[orderedMap.OrderedMap[Key, T]] {
+	return Map(func(pairs []functional.Pair[Key, T]) orderedMap.OrderedMap[Key, T] {
+		return orderedMap.FromSlice(pairs, lessFunc...)
+	}, SliceOf(PairOf(keys, values)))
+}
+
+// PairOf creates a Gen of functional.Pairs, shrinking the first item and then the second.
+func PairOf[T, T2 any](first Gen[T], second Gen[T2]) Gen[functional.Pair[T, T2]] {
+	return Gen[functional.Pair[T, T2]]{generate: func(r *rand.Rand, size int) tree[functional.Pair[T, T2]] {
+		a := first.generate(r, size)
+		return pairTree(a, second.generate(r, size))
+	}}
+}
+
+func pairTree[T, T2 any](a tree[T], b tree[T2]) tree[functional.Pair[T, T2]] {
+	return tree[functional.Pair[T, T2]]{
+		value: functional.PairOf(a.value, b.value),
+		shrink: func() []tree[functional.Pair[T, T2]] {
+			shrinks := list.Map(func(c tree[T]) tree[functional.Pair[T, T2]] { return pairTree(c, b) }, a.shrink())
+			return append(shrinks, list.Map(func(c tree[T2]) tree[functional.Pair[T, T2]] { return pairTree(a, c) }, b.shrink())...)
+		},
+	}
+}
+
+// TripleOf creates a Gen of functional.Triples, shrinking each item in turn.
+func TripleOf[T, T2, T3 any](first Gen[T], second Gen[T2], third Gen[T3]) Gen[functional.Triple[T, T2, T3]] {
+	return Map(func(p functional.Pair[functional.Pair[T, T2], T3]) functional.Triple[T, T2, T3] {
+		return functional.TripleOf(p.First.First, p.First.Second, p.Second)
+	}, PairOf(PairOf(first, second), third))
+}
+
+// OptionOf creates a Gen of option.Options that are None about a quarter of the time
+// and otherwise hold a value generated by g. Some values shrink to None first.
+func OptionOf[T any](g Gen[T]) Gen[option.Option[T]] {
+	return Gen[option.Option[T]]{generate: func(r *rand.Rand, size int) tree[option.Option[T]] {
+		if r.IntN(4) == 0 {
+			return leaf(option.None[T]())
+		}
+		some := mapTree(option.Some[T], g.generate(r, size))
+		return tree[option.Option[T]]{
+			value: some.value,
+			shrink: func() []tree[option.Option[T]] {
+				return append([]tree[option.Option[T]]{leaf(option.None[T]())}, some.shrink()...)
+			},
+		}
+	}}
+}
+
+// ResultOf creates a Gen of result.Results that are a Failure generated by failure about a quarter of the time
+// and otherwise a Success generated by success.
+func ResultOf[S, F any](success Gen[S], failure Gen[F]) Gen[result.Result[S, F]] {
+	return Gen[result.Result[S, F]]{generate: func(r *rand.Rand, size int) tree[result.Result[S, F]] {
+		if r.IntN(4) == 0 {
+			return mapTree(result.Failure[S, F], failure.generate(r, size))
+		}
+		return mapTree(result.Success[S, F], success.generate(r, size))
+	}}
+}
@@ -0,0 +1,123 @@
+// Package prop provides property-based testing in the spirit of testing/quick.
+// Generators produce random values, ForAll checks that a property holds for many of them,
+// and when it does not, the failing value is shrunk to a minimal counterexample
+// that is reported along with the seed needed to replay it.
+package prop
+
+import (
+	"math/rand/v2"
+
+	"github.com/flowonyx/functional/list"
+)
+
+// Gen generates random values of T along with the smaller values to try when a property fails.
+// Shrinking is integrated into generation, so generators built with Map, Bind and the other
+// combinators shrink through the values they were built from without any extra work.
+type Gen[T any] struct {
+	generate func(r *rand.Rand, size int) tree[T]
+}
+
+// tree is a generated value together with its lazily computed shrinks, most aggressive first.
+type tree[T any] struct {
+	value  T
+	shrink func() []tree[T]
+}
+
+func leaf[T any](value T) tree[T] {
+	return tree[T]{value: value, shrink: func() []tree[T] { return nil }}
+}
+
+func mapTree[T, R any](mapping func(T) R, t tree[T]) tree[R] {
+	return tree[R]{
+		value: mapping(t.value),
+		shrink: func() []tree[R] {
+			return list.Map(func(c tree[T]) tree[R] { return mapTree(mapping, c) }, t.shrink())
+		},
+	}
+}
+
+func filterTree[T any](predicate func(T) bool, t tree[T]) tree[T] {
+	return tree[T]{
+		value: t.value,
+		shrink: func() []tree[T] {
+			kept := list.Filter(func(c tree[T]) bool { return predicate(c.value) }, t.shrink()...)
+			return list.Map(func(c tree[T]) tree[T] { return filterTree(predicate, c) }, kept)
+		},
+	}
+}
+
+// Sample generates a single value from g, which is useful for building test fixtures.
+// size limits the length of generated collections and the range of numbers close to zero.
+// If r is nil, a randomly seeded source is used.
+func (g Gen[T]) Sample(r *rand.Rand, size int) T {
+	if r == nil {
+		r = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	return g.generate(r, size).value
+}
+
+// Const creates a Gen that always generates value.
+func Const[T any](value T) Gen[T] {
+	return Gen[T]{generate: func(*rand.Rand, int) tree[T] { return leaf(value) }}
+}
+
+// Map creates a Gen that applies mapping to the values generated by g.
+// The results shrink by shrinking the original values.
+func Map[T, R any](mapping func(T) R, g Gen[T]) Gen[R] {
+	return Gen[R]{generate: func(r *rand.Rand, size int) tree[R] {
+		return mapTree(mapping, g.generate(r, size))
+	}}
+}
+
+// Filter creates a Gen that only generates values from g for which predicate returns true.
+// It panics if 100 values in a row are rejected, so predicate should not reject most values.
+func Filter[T any](predicate func(T) bool, g Gen[T]) Gen[T] {
+	return Gen[T]{generate: func(r *rand.Rand, size int) tree[T] {
+		for try := 0; try < 100; try++ {
+			if t := g.generate(r, size+try/10); predicate(t.value) {
+				return filterTree(predicate, t)
+			}
+		}
+		panic("prop.Filter: 100 generated values in a row were rejected")
+	}}
+}
+
+// Bind creates a Gen that uses a value generated by g to choose the Gen for the result.
+// The results shrink by shrinking the value from g first, then the value from the chosen Gen.
+func Bind[T, R any](g Gen[T], bind func(T) Gen[R]) Gen[R] {
+	return Gen[R]{generate: func(r *rand.Rand, size int) tree[R] {
+		outer := g.generate(r, size)
+		// the chosen Gen is rerun with the same seed each time the outer value shrinks
+		seed1, seed2 := r.Uint64(), r.Uint64()
+		return bindTree(outer, bind, seed1, seed2, size)
+	}}
+}
+
+func bindTree[T, R any](outer tree[T], bind func(T) Gen[R], seed1, seed2 uint64, size int) tree[R] {
+	inner := bind(outer.value).generate(rand.New(rand.NewPCG(seed1, seed2)), size)
+	return tree[R]{
+		value: inner.value,
+		shrink: func() []tree[R] {
+			shrinks := list.Map(func(c tree[T]) tree[R] { return bindTree(c, bind, seed1, seed2, size) }, outer.shrink())
+			return append(shrinks, inner.shrink()...)
+		},
+	}
+}
+
+// OneOf creates a Gen that chooses one of values, shrinking toward the first.
+// It panics if no values are given.
+func OneOf[T any](values ...T) Gen[T] {
+	if len(values) == 0 {
+		panic("prop.OneOf: no values to choose from")
+	}
+	return Map(func(i int) T { return values[i] }, Int(0, len(values)-1))
+}
+
+// OneOfGen creates a Gen that uses one of gens to generate each value, shrinking toward the first.
+// It panics if no generators are given.
+func OneOfGen[T any](gens ...Gen[T]) Gen[T] {
+	if len(gens) == 0 {
+		panic("prop.OneOfGen: no generators to choose from")
+	}
+	return Bind(Int(0, len(gens)-1), func(i int) Gen[T] { return gens[i] })
+}
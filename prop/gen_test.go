@@ -0,0 +1,67 @@
+package prop_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/option"
+	"github.com/flowonyx/functional/prop"
+	"github.com/flowonyx/functional/result"
+	"github.com/flowonyx/functional/set"
+)
+
+func ExampleMap() {
+	even := prop.Map(func(i int) int { return i * 2 }, prop.Int(-50, 50))
+	err := prop.Check(even, func(i int) bool { return i%2 == 0 })
+	fmt.Println(err)
+	// Output: <nil>
+}
+
+func ExampleBind() {
+	// a slice together with a valid index into it
+	gen := prop.Bind(prop.SliceOfN(1, 10, prop.Int(0, 9)), func(s []int) prop.Gen[functional.Pair[[]int, int]] {
+		return prop.Map(func(i int) functional.Pair[[]int, int] { return functional.PairOf(s, i) }, prop.Int(0, len(s)-1))
+	})
+	err := prop.Check(gen, func(p functional.Pair[[]int, int]) bool { return p.Second < len(p.First) })
+	fmt.Println(err)
+	// Output: <nil>
+}
+
+func ExampleGen_Sample() {
+	r := rand.New(rand.NewPCG(1, 2))
+	s := prop.SliceOfN(3, 3, prop.OneOf("red", "green", "blue")).Sample(r, 10)
+	fmt.Println(len(s))
+	// Output: 3
+}
+
+func TestGeneratorsStayInRange(t *testing.T) {
+	prop.ForAll(t, prop.Int[int8](-3, 7), func(i int8) bool { return i >= -3 && i <= 7 })
+	prop.ForAll(t, prop.Int[uint](10, 20), func(i uint) bool { return i >= 10 && i <= 20 })
+	prop.ForAll(t, prop.Float(0.5, 2.5), func(f float64) bool { return f >= 0.5 && f <= 2.5 })
+	prop.ForAll(t, prop.SliceOfN(2, 4, prop.Bool()), func(s []bool) bool { return len(s) >= 2 && len(s) <= 4 })
+	prop.ForAll(t, prop.StringOf(prop.RuneOf("ab")), func(s string) bool {
+		for _, r := range s {
+			if r != 'a' && r != 'b' {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestCompositeGenerators(t *testing.T) {
+	prop.ForAll(t, prop.SetOf(prop.Int(0, 20)), func(s set.Set[int]) bool {
+		return s.Count() <= 21
+	})
+	prop.ForAll(t, prop.OptionOf(prop.Int(1, 5)), func(o option.Option[int]) bool {
+		return o.IsNone() || o.Value() >= 1
+	})
+	prop.ForAll(t, prop.ResultOf(prop.Int(1, 5), prop.String()), func(r result.Result[int, string]) bool {
+		return r.IsFailure() || r.SuccessValue() >= 1
+	})
+	prop.ForAll(t, prop.TripleOf(prop.Bool(), prop.Int(0, 1), prop.String()), func(tr functional.Triple[bool, int, string]) bool {
+		return tr.Second <= 1
+	})
+}
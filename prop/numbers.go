@@ -0,0 +1,132 @@
+package prop
+
+import (
+	"math"
+	"math/rand/v2"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Int creates a Gen of integers from lo to hi (inclusive).
+// Half of the values are close to zero, within the size of the run, because that is where bugs usually are.
+// Values shrink toward zero, or toward whichever of lo and hi is closest to it.
+// It panics if lo is greater than hi.
+func Int[T constraints.Integer](lo, hi T) Gen[T] {
+	if lo > hi {
+		panic("prop.Int: lo is greater than hi")
+	}
+	origin := T(0)
+	switch {
+	case lo > 0:
+		origin = lo
+	case hi < 0:
+		origin = hi
+	}
+	return Gen[T]{generate: func(r *rand.Rand, size int) tree[T] {
+		// the differences are computed as uint64 so they cannot overflow T
+		var x T
+		if r.IntN(2) == 0 {
+			span := uint64(hi) - uint64(lo)
+			if span == math.MaxUint64 {
+				x = lo + T(r.Uint64())
+			} else {
+				x = lo + T(r.Uint64N(span+1))
+			}
+		} else {
+			offset := uint64(r.IntN(size + 1))
+			if r.IntN(2) == 0 {
+				x = origin + T(min(offset, uint64(hi)-uint64(origin)))
+			} else {
+				x = origin - T(min(offset, uint64(origin)-uint64(lo)))
+			}
+		}
+		return intTree(x, origin)
+	}}
+}
+
+func intTree[T constraints.Integer](x, origin T) tree[T] {
+	return tree[T]{
+		value: x,
+		shrink: func() []tree[T] {
+			if x == origin {
+				return nil
+			}
+			shrinks := []tree[T]{intTree(origin, origin)}
+			// halve the distance to origin each time; halving both values first avoids overflow
+			if x > origin {
+				for d := x/2 - origin/2; d > 0; d /= 2 {
+					shrinks = append(shrinks, intTree(x-d, origin))
+				}
+			} else {
+				for d := origin/2 - x/2; d > 0; d /= 2 {
+					shrinks = append(shrinks, intTree(x+d, origin))
+				}
+			}
+			return shrinks
+		},
+	}
+}
+
+// AnyInt creates a Gen of integers covering every value of T.
+func AnyInt[T constraints.Integer]() Gen[T] {
+	var zero T
+	bits := unsafe.Sizeof(zero) * 8
+	if zero-1 > zero {
+		return Int(zero, ^zero)
+	}
+	largest := T(uint64(1)<<(bits-1) - 1)
+	return Int(-largest-1, largest)
+}
+
+// Float creates a Gen of floats from lo to hi (inclusive).
+// Values shrink toward zero, or toward whichever of lo and hi is closest to it, trying whole numbers first.
+// It panics if lo is greater than hi.
+func Float[T constraints.Float](lo, hi T) Gen[T] {
+	if lo > hi {
+		panic("prop.Float: lo is greater than hi")
+	}
+	origin := T(0)
+	switch {
+	case lo > 0:
+		origin = lo
+	case hi < 0:
+		origin = hi
+	}
+	return Gen[T]{generate: func(r *rand.Rand, size int) tree[T] {
+		from, to := lo, hi
+		if r.IntN(2) == 0 {
+			from, to = max(lo, origin-T(size)), min(hi, origin+T(size))
+		}
+		return floatTree(from+T(r.Float64())*(to-from), origin)
+	}}
+}
+
+func floatTree[T constraints.Float](x, origin T) tree[T] {
+	return tree[T]{
+		value: x,
+		shrink: func() []tree[T] {
+			if x == origin {
+				return nil
+			}
+			shrinks := []tree[T]{floatTree(origin, origin)}
+			if whole := T(math.Trunc(float64(x))); whole != x && (whole-origin)*(x-origin) > 0 {
+				shrinks = append(shrinks, floatTree(whole, origin))
+			}
+			for i, d := 0, (x-origin)/2; i < 8 && d != 0; i, d = i+1, d/2 {
+				shrinks = append(shrinks, floatTree(x-d, origin))
+			}
+			return shrinks
+		},
+	}
+}
+
+// Bool creates a Gen of bools that shrinks toward false.
+func Bool() Gen[bool] {
+	return Gen[bool]{generate: func(r *rand.Rand, _ int) tree[bool] {
+		if r.IntN(2) == 0 {
+			return leaf(false)
+		}
+		return tree[bool]{value: true, shrink: func() []tree[bool] { return []tree[bool]{leaf(false)} }}
+	}}
+}
@@ -0,0 +1,145 @@
+package prop
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+)
+
+// SeedEnv is the environment variable that sets the seed for every check that does not use the Seed option,
+// so a failure reported by go test can be replayed with PROP_SEED=<seed> go test.
+const SeedEnv = "PROP_SEED"
+
+// TestingT is the part of testing.TB used by ForAll.
+type TestingT interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}
+
+// CheckOption changes how Check and ForAll run.
+type CheckOption func(*checkConfig)
+
+type checkConfig struct {
+	runs       int
+	maxSize    int
+	maxShrinks int
+	seed       option.Option[uint64]
+}
+
+// Runs sets the number of values to check. The default is 100.
+func Runs(n int) CheckOption {
+	return func(c *checkConfig) { c.runs = n }
+}
+
+// MaxSize sets the size used for the last run. The size grows from 0 toward it, so early runs
+// check small values and collections. The default is 100.
+func MaxSize(n int) CheckOption {
+	return func(c *checkConfig) { c.maxSize = n }
+}
+
+// MaxShrinks limits the number of shrinking steps taken after a failure. The default is 1000.
+func MaxShrinks(n int) CheckOption {
+	return func(c *checkConfig) { c.maxShrinks = n }
+}
+
+// Seed sets the seed for the random values, so a reported failure can be replayed.
+// Without it, the seed comes from the PROP_SEED environment variable or is chosen at random.
+func Seed(seed uint64) CheckOption {
+	return func(c *checkConfig) { c.seed = option.Some(seed) }
+}
+
+// Failure is the error returned by Check when a property does not hold.
+type Failure[T any] struct {
+	// Seed replays the failure when passed to the Seed option with the same Runs and MaxSize.
+	Seed uint64
+	// Run is the number of values that were checked, including the one that failed.
+	Run int
+	// Original is the first value that failed and Shrunk is the smallest failing value found from it.
+	Original, Shrunk T
+	// Shrinks is the number of shrinking steps from Original to Shrunk.
+	Shrinks int
+	// Panic holds the value passed to panic if the property panicked for Shrunk.
+	Panic any
+}
+
+func (f *Failure[T]) Error() string {
+	reason := "property does not hold"
+	if f.Panic != nil {
+		reason = fmt.Sprintf("property panicked: %v", f.Panic)
+	}
+	return fmt.Sprintf("%s for %#v after %d runs (shrunk %d times from %#v); replay with prop.Seed(%d) or %s=%d",
+		reason, f.Shrunk, f.Run, f.Shrinks, f.Original, f.Seed, SeedEnv, f.Seed)
+}
+
+// holds runs property for value, treating a panic as a failure.
+func holds[T any](property func(T) bool, value T) (ok bool, panicked any) {
+	defer func() {
+		if p := recover(); p != nil {
+			ok, panicked = false, p
+		}
+	}()
+	return property(value), nil
+}
+
+// Check tests property with values generated by gen and returns a *Failure with the smallest
+// counterexample it can find, or nil if the property held for every value.
+// A property that panics is treated as failing.
+func Check[T any](gen Gen[T], property func(T) bool, opts ...CheckOption) error {
+	config := checkConfig{runs: 100, maxSize: 100, maxShrinks: 1000}
+	list.Iter(func(opt CheckOption) { opt(&config) }, opts)
+	seed := option.DefaultWith(func() uint64 {
+		if s, err := strconv.ParseUint(os.Getenv(SeedEnv), 10, 64); err == nil {
+			return s
+		}
+		return rand.Uint64()
+	}, config.seed)
+	r := rand.New(rand.NewPCG(seed, seed))
+
+	for run := 0; run < config.runs; run++ {
+		size := 0
+		if config.runs > 1 {
+			size = run * config.maxSize / (config.runs - 1)
+		}
+		t := gen.generate(r, size)
+		ok, panicked := holds(property, t.value)
+		if ok {
+			continue
+		}
+		failure := &Failure[T]{Seed: seed, Run: run + 1, Original: t.value, Panic: panicked}
+		for failure.Shrinks < config.maxShrinks {
+			next := list.TryFind(func(c tree[T]) bool {
+				ok, p := holds(property, c.value)
+				if !ok {
+					panicked = p
+				}
+				return !ok
+			}, t.shrink()...)
+			if next.IsNone() {
+				break
+			}
+			t = next.Value()
+			failure.Shrinks++
+			failure.Panic = panicked
+		}
+		failure.Shrunk = t.value
+		return failure
+	}
+	return nil
+}
+
+// ForAll tests property with values generated by gen, failing t with the smallest
+// counterexample it can find and the seed to replay it.
+//
+//	prop.ForAll(t, prop.SliceOf(prop.Int(-100, 100)), func(s []int) bool {
+//		return list.Equal(list.Reverse(list.Reverse(s)), s)
+//	})
+func ForAll[T any](t TestingT, gen Gen[T], property func(T) bool, opts ...CheckOption) {
+	t.Helper()
+	if err := Check(gen, property, opts...); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
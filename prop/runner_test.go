@@ -0,0 +1,95 @@
+package prop_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+)
+
+func ExampleCheck() {
+	err := prop.Check(prop.SliceOf(prop.Int(0, 1000)), func(s []int) bool {
+		return list.Sum(s) < 100
+	}, prop.Seed(42))
+	var failure *prop.Failure[[]int]
+	if errors.As(err, &failure) {
+		fmt.Println(failure.Shrunk)
+	}
+	// Output: [100]
+}
+
+func TestShrinkInt(t *testing.T) {
+	for _, seed := range []uint64{1, 2, 3, 4, 5} {
+		err := prop.Check(prop.AnyInt[int64](), func(i int64) bool { return i < 1000 }, prop.Seed(seed))
+		var failure *prop.Failure[int64]
+		if !errors.As(err, &failure) {
+			t.Fatalf("expected a failure but got %v", err)
+		}
+		if failure.Shrunk != 1000 {
+			t.Errorf("seed %d: expected to shrink to 1000 but got %d from %d", seed, failure.Shrunk, failure.Original)
+		}
+	}
+}
+
+func TestShrinkNegativeRange(t *testing.T) {
+	err := prop.Check(prop.Int(-500, -10), func(i int) bool { return i > -100 }, prop.Seed(9))
+	var failure *prop.Failure[int]
+	if !errors.As(err, &failure) || failure.Shrunk != -100 {
+		t.Errorf("expected to shrink to -100 but got %v", err)
+	}
+}
+
+func TestShrinkString(t *testing.T) {
+	err := prop.Check(prop.String(), func(s string) bool { return !strings.ContainsRune(s, 'z') }, prop.Seed(7))
+	var failure *prop.Failure[string]
+	if !errors.As(err, &failure) || failure.Shrunk != "z" {
+		t.Errorf(`expected to shrink to "z" but got %v`, err)
+	}
+}
+
+func TestPanicIsFailure(t *testing.T) {
+	err := prop.Check(prop.SliceOf(prop.Int(0, 10)), func(s []int) bool { return s[0] >= 0 }, prop.Seed(1))
+	var failure *prop.Failure[[]int]
+	if !errors.As(err, &failure) {
+		t.Fatalf("expected a failure but got %v", err)
+	}
+	if len(failure.Shrunk) != 0 || failure.Panic == nil {
+		t.Errorf("expected an empty slice that panics but got %v", err)
+	}
+}
+
+func TestSeedReplay(t *testing.T) {
+	property := func(m map[int]string) bool { return len(m) < 5 }
+	gen := prop.MapOf(prop.Int(0, 100), prop.String())
+	first := prop.Check(gen, property, prop.Seed(11))
+	second := prop.Check(gen, property, prop.Seed(11))
+	if first == nil || first.Error() != second.Error() {
+		t.Errorf("expected the same failure twice but got %v and %v", first, second)
+	}
+	t.Setenv(prop.SeedEnv, "11")
+	if third := prop.Check(gen, property); third == nil || third.Error() != first.Error() {
+		t.Errorf("expected %s to replay %v but got %v", prop.SeedEnv, first, third)
+	}
+}
+
+type recordingT struct {
+	failed  bool
+	message string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Fatalf(format string, args ...any) {
+	r.failed, r.message = true, fmt.Sprintf(format, args...)
+}
+
+func TestForAllReportsSeed(t *testing.T) {
+	rt := &recordingT{}
+	prop.ForAll(rt, prop.Int(0, 10), func(i int) bool { return i < 5 }, prop.Seed(3))
+	if !rt.failed || !strings.Contains(rt.message, "prop.Seed(3)") || !strings.Contains(rt.message, "for 5 ") {
+		t.Errorf("unexpected report: %q", rt.message)
+	}
+}
@@ -0,0 +1,22 @@
+package result
+
+import "github.com/flowonyx/functional/errors"
+
+// Catch applies handler to the error of r if it is a Failure and errors.Is(error, target) is true.
+// Otherwise it returns r unchanged, so other failures continue down the pipeline.
+func Catch[S any](target error, handler func(error) Result[S, error], r Result[S, error]) Result[S, error] {
+	if r.IsFailure() && errors.Is(r.FailureValue(), target) {
+		return handler(r.FailureValue())
+	}
+	return r
+}
+
+// CatchAs applies handler to the error of r if it is a Failure and its chain contains an error of type E.
+// Otherwise it returns r unchanged, so other failures continue down the pipeline.
+func CatchAs[E error, S any](handler func(E) Result[S, error], r Result[S, error]) Result[S, error] {
+	var target E
+	if r.IsFailure() && errors.As(r.FailureValue(), &target) {
+		return handler(target)
+	}
+	return r
+}
@@ -0,0 +1,38 @@
+package result_test
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/result"
+)
+
+func ExampleCatch() {
+	load := func(err error) result.Result[string, error] {
+		if err != nil {
+			return result.Failure[string](err)
+		}
+		return result.Success[string, error]("loaded")
+	}
+	useDefault := func(error) result.Result[string, error] {
+		return result.Success[string, error]("default")
+	}
+	fmt.Println(result.Catch(fs.ErrNotExist, useDefault, load(fs.ErrNotExist)).SuccessValue())
+	fmt.Println(result.Catch(fs.ErrNotExist, useDefault, load(fs.ErrPermission)).FailureValue())
+	fmt.Println(result.Catch(fs.ErrNotExist, useDefault, load(nil)).SuccessValue())
+	// Output:
+	// default
+	// permission denied
+	// loaded
+}
+
+func ExampleCatchAs() {
+	r := result.Failure[int, error](fmt.Errorf("reading: %w", errors.IndexOutOfRange("Item", 4, 2)))
+	r = result.CatchAs(func(e *errors.Error) result.Result[int, error] {
+		length, _ := e.Field(errors.LengthKey)
+		return result.Success[int, error](length.(int))
+	}, r)
+	fmt.Println(r.SuccessValue())
+	// Output: 2
+}
@@ -0,0 +1,51 @@
+package functional
+
+// Seq is a lazy sequence of values. It calls yield with each value in turn until yield returns false
+// or there are no more values. It has the same form as iter.Seq, so it can be converted to one
+// and, with go 1.23 or later, used in a for range loop.
+// It is used for outputs that are too large to build all at once, such as every combination of a slice.
+type Seq[T any] func(yield func(T) bool)
+
+// ToSlice runs the sequence and returns every value in it.
+func (s Seq[T]) ToSlice() []T {
+	var output []T
+	s(func(v T) bool {
+		output = append(output, v)
+		return true
+	})
+	return output
+}
+
+// Iter applies action to each value in the sequence.
+func (s Seq[T]) Iter(action func(T)) {
+	s(func(v T) bool {
+		action(v)
+		return true
+	})
+}
+
+// Take returns a sequence of the first n values in the sequence, or all of them if there are fewer.
+// Values after the first n are never produced.
+func (s Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		s(func(v T) bool {
+			taken++
+			return yield(v) && taken < n
+		})
+	}
+}
+
+// SeqOf returns a sequence of the values.
+func SeqOf[T any](values ...T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
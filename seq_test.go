@@ -0,0 +1,18 @@
+package functional_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional"
+)
+
+func ExampleSeq() {
+	s := functional.SeqOf(1, 2, 3, 4)
+	fmt.Println(s.ToSlice())
+	fmt.Println(s.Take(2).ToSlice(), s.Take(0).ToSlice())
+	s.Take(3).Iter(func(i int) { fmt.Print(i*10, " ") })
+	// Output:
+	// [1 2 3 4]
+	// [1 2] []
+	// 10 20 30
+}
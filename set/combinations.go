@@ -0,0 +1,114 @@
+package set
+
+import (
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+)
+
+// PowerSet returns a lazy sequence of every subset of s, starting with the empty set,
+// then each subset of one item, and so on up to s itself.
+// Subsets of the same size come in the order of the items in s.
+// Each subset keeps the less function s was created with.
+// A Set of n items has 2ⁿ subsets, so only as many as are used are made.
+func PowerSet[T comparable](s Set[T]) functional.Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		for k := 0; k <= s.Count(); k++ {
+			more := true
+			Combinations(k, s)(func(subset Set[T]) bool {
+				more = yield(subset)
+				return more
+			})
+			if !more {
+				return
+			}
+		}
+	}
+}
+
+// Combinations returns a lazy sequence of every subset of s with exactly k items.
+// The items in each subset, and the subsets themselves, follow the order of the items in s,
+// and each subset keeps the less function s was created with.
+// If k is negative or larger than the number of items in s, the sequence is empty.
+func Combinations[T comparable](k int, s Set[T]) functional.Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		list.Combinations(k, s.Items())(func(items []T) bool {
+			return yield(s.subset(items))
+		})
+	}
+}
+
+// Permutations returns a lazy sequence of every ordering of the items in s.
+// The first ordering is the order of the items in s.
+func Permutations[T comparable](s Set[T]) functional.Seq[[]T] {
+	return list.Permutations(s.Items())
+}
+
+// CartesianProduct returns a lazy sequence of every tuple made by taking one item from each of the sets,
+// with the items in the order of the sets. The items of the last Set change fastest.
+// If any Set is empty, so is the sequence.
+// Use CartesianProduct2 or CartesianProduct3 for sets of different types.
+func CartesianProduct[T comparable](sets ...Set[T]) functional.Seq[[]T] {
+	return list.CartesianProduct(list.Map(Set[T].Items, sets)...)
+}
+
+// CartesianProduct2 returns a lazy sequence of every Pair made by taking one item from s1 and one from s2.
+// The items of s2 change fastest.
+func CartesianProduct2[T1, T2 comparable](s1 Set[T1], s2 Set[T2]) functional.Seq[functional.Pair[T1, T2]] {
+	return func(yield func(functional.Pair[T1, T2]) bool) {
+		items2 := s2.Items()
+		for _, a := range s1.Items() {
+			for _, b := range items2 {
+				if !yield(functional.PairOf(a, b)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CartesianProduct3 returns a lazy sequence of every Triple made by taking one item from each of s1, s2 and s3.
+// The items of s3 change fastest.
+func CartesianProduct3[T1, T2, T3 comparable](s1 Set[T1], s2 Set[T2], s3 Set[T3]) functional.Seq[functional.Triple[T1, T2, T3]] {
+	return func(yield func(functional.Triple[T1, T2, T3]) bool) {
+		items3 := s3.Items()
+		CartesianProduct2(s1, s2)(func(p functional.Pair[T1, T2]) bool {
+			for _, c := range items3 {
+				if !yield(functional.TripleOf(p.First, p.Second, c)) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// subset creates a Set of items that orders them the same way as s.
+func (s Set[T]) subset(items []T) Set[T] {
+	output := s.empty()
+	list.Iter(output.Add, items)
+	return output
+}
+
+// Choose applies chooser to each item in s and returns a Set of the values within each Some.
+func Choose[T, R comparable](chooser func(T) option.Option[R], s Set[T]) Set[R] {
+	return FromSlice(list.Choose(chooser, s.Items()))
+}
+
+// Collect applies projection to each item in s and returns the union of the resulting Sets.
+func Collect[T, R comparable](projection func(T) Set[R], s Set[T]) Set[R] {
+	return UnionMany(list.Map(projection, s.Items())...)
+}
+
+// GroupBy splits the items in s into Sets by the key returned by projection.
+// The groups are in the order their keys are first seen, and each keeps the less function s was created with.
+func GroupBy[T, Key comparable](projection func(T) Key, s Set[T]) []functional.Pair[Key, Set[T]] {
+	return list.Map(func(p functional.Pair[Key, []T]) functional.Pair[Key, Set[T]] {
+		return functional.PairOf(p.First, s.subset(p.Second))
+	}, list.GroupBy(projection, s.Items()))
+}
+
+// Reduce applies f to each item in s, threading an accumulator argument through the computation.
+func Reduce[T comparable, R any](initial R, f func(accumulator R, each T) R, s Set[T]) R {
+	return list.Reduce(initial, f, s.Items())
+}
@@ -0,0 +1,122 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+)
+
+func items[T comparable](sets []Set[T]) [][]T {
+	return list.Map(Set[T].Items, sets)
+}
+
+func ExamplePowerSet() {
+	s := FromSlice([]string{"a", "b", "c"})
+	fmt.Println(items(PowerSet(s).ToSlice()))
+	fmt.Println(items(PowerSet(FromSlice(list.InitSlice(40, func(i int) int { return i }))).Take(3).ToSlice()))
+	// Output:
+	// [[] [a] [b] [c] [a b] [a c] [b c] [a b c]]
+	// [[] [0] [1]]
+}
+
+func ExampleCombinations() {
+	s := FromSlice([]int{1, 2, 3, 4})
+	fmt.Println(items(Combinations(3, s).ToSlice()))
+	// Output: [[1 2 3] [1 2 4] [1 3 4] [2 3 4]]
+}
+
+func ExamplePermutations() {
+	fmt.Println(Permutations(FromSlice([]string{"x", "y", "z"})).ToSlice())
+	// Output: [[x y z] [x z y] [y x z] [y z x] [z x y] [z y x]]
+}
+
+func ExampleCartesianProduct() {
+	sizes := FromSlice([]string{"S", "M"})
+	colors := FromSlice([]string{"red", "blue"})
+	fits := FromSlice([]string{"slim", "loose"})
+	CartesianProduct(sizes, colors, fits).Take(3).Iter(func(t []string) { fmt.Println(t) })
+	// Output:
+	// [S red slim]
+	// [S red loose]
+	// [S blue slim]
+}
+
+func ExampleCartesianProduct2() {
+	p := CartesianProduct2(FromSlice([]int{1, 2}), FromSlice([]string{"a", "b"}))
+	fmt.Println(p.ToSlice(), p.Take(1).ToSlice())
+	fmt.Println(CartesianProduct3(FromSlice([]int{1, 2}), FromSlice([]string{"a", "b"}), FromSlice([]bool{true, false})).Take(3).ToSlice())
+	// Output:
+	// [(1, "a") (1, "b") (2, "a") (2, "b")] [(1, "a")]
+	// [(1, "a", true) (1, "a", false) (1, "b", true)]
+}
+
+func ExampleChoose() {
+	s := FromSlice([]int{1, 2, 3, 4, 5, 6})
+	r := Choose(func(i int) option.Option[int] {
+		if i%2 == 0 {
+			return option.Some(i / 2)
+		}
+		return option.None[int]()
+	}, s)
+	fmt.Println(r.Items())
+	// Output: [1 2 3]
+}
+
+func ExampleCollect() {
+	s := FromSlice([]string{"a b", "b c", "c d"})
+	r := Collect(func(words string) Set[string] { return FromSlice(strings.Fields(words)) }, s)
+	fmt.Println(r.Items())
+	// Output: [a b c d]
+}
+
+func ExampleGroupBy() {
+	s := FromSlice([]string{"apple", "avocado", "banana", "blueberry", "cherry"})
+	groups := GroupBy(func(fruit string) byte { return fruit[0] }, s)
+	for _, g := range groups {
+		fmt.Println(string(g.First), g.Second.Items())
+	}
+	// Output:
+	// a [apple avocado]
+	// b [banana blueberry]
+	// c [cherry]
+}
+
+func ExampleReduce() {
+	s := FromSlice([]string{"one", "two", "three"})
+	fmt.Println(Reduce(0, func(total int, word string) int { return total + len(word) }, s))
+	// Output: 11
+}
+
+func TestPowerSet(t *testing.T) {
+	for n := 0; n <= 8; n++ {
+		s := FromSlice(list.InitSlice(n, func(i int) int { return i * i }))
+		subsets := PowerSet(s).ToSlice()
+		distinct := list.DistinctBy(func(sub Set[int]) string { return fmt.Sprint(sub.Items()) }, subsets...)
+		if len(subsets) != 1<<n || len(distinct) != len(subsets) {
+			t.Fatalf("PowerSet of %d items: got %d subsets, %d distinct", n, len(subsets), len(distinct))
+		}
+		if !list.ForAll(func(sub Set[int]) bool { return sub.IsSubsetOf(s) }, subsets) {
+			t.Fatalf("PowerSet of %v: not every result is a subset", s.Items())
+		}
+	}
+}
+
+func TestSubsetsKeepOrder(t *testing.T) {
+	s := FromSlice([]int{5, 3, 1}, cmp.Compare[int])
+	check := func(name string, subset Set[int]) {
+		subset.Add(0)
+		subset.Add(4)
+		if items := subset.Items(); !slices.IsSorted(items) {
+			t.Errorf("%s gave a subset that is not kept in order: %v", name, items)
+		}
+	}
+	PowerSet(s).Iter(func(subset Set[int]) { check("PowerSet", subset) })
+	Combinations(2, s).Iter(func(subset Set[int]) { check("Combinations", subset) })
+	list.Iter(func(p functional.Pair[bool, Set[int]]) { check("GroupBy", p.Second) }, GroupBy(func(i int) bool { return i > 2 }, s))
+}
@@ -10,19 +10,22 @@ import (
 
 // Set is a type keeps a set of values and allows set operations on them.
 type Set[T comparable] struct {
-	m orderedMap.OrderedMap[T, struct{}]
+	m    orderedMap.OrderedMap[T, struct{}]
+	less func(T, T) int
 }
 
 // NewSet creates a new Set.
 // If lessFunc is provided, it is used in ordering the set.
 func NewSet[T comparable](lessFunc ...func(T, T) int) Set[T] {
 	var lf func(functional.Pair[T, struct{}], functional.Pair[T, struct{}]) int
+	var less func(T, T) int
 	if len(lessFunc) > 0 {
+		less = lessFunc[0]
 		lf = func(p functional.Pair[T, struct{}], p2 functional.Pair[T, struct{}]) int {
-			return lessFunc[0](p.First, p2.First)
+			return less(p.First, p2.First)
 		}
 	}
-	return Set[T]{m: orderedMap.NewOrderedMap(lf)}
+	return Set[T]{m: orderedMap.NewOrderedMap(lf), less: less}
 }
 
 // Singleton creates a set of exactly one item.
@@ -35,11 +38,15 @@ func Singleton[T comparable](item T) Set[T] {
 }
 
 func (s Set[T]) clone() Set[T] {
-	n := NewSet[T]()
-	for _, i := range s.Items() {
-		n.Add(i)
+	return Set[T]{m: s.m.Clone(), less: s.less}
+}
+
+// empty creates an empty Set that orders items the same way as s.
+func (s Set[T]) empty() Set[T] {
+	if s.less == nil {
+		return NewSet[T]()
 	}
-	return n
+	return NewSet(s.less)
 }
 
 // FromSlice creates a new Set from the items in the given slice.
@@ -161,6 +168,7 @@ func (s Set[T]) Items() []T {
 }
 
 // Difference returns a Set containing the items that are only present in one Set or the other.
+// Despite its name, this is the symmetric difference and is the same as SymmetricDifference.
 func (s Set[T]) Difference(set2 Set[T]) Set[T] {
 	output := NewSet[T]()
 	for _, i := range s.Items() {
@@ -176,6 +184,20 @@ func (s Set[T]) Difference(set2 Set[T]) Set[T] {
 	return output
 }
 
+// SymmetricDifference returns a Set containing the items that are only present in one Set or the other.
+// The items only in this Set come first, followed by those only in set2.
+func (s Set[T]) SymmetricDifference(set2 Set[T]) Set[T] {
+	return s.Difference(set2)
+}
+
+// IsDisjoint tests whether the Sets have no items in common.
+func (s Set[T]) IsDisjoint(set2 Set[T]) bool {
+	if set2.Count() < s.Count() {
+		return set2.IsDisjoint(s)
+	}
+	return !s.Exists(set2.Contains)
+}
+
 // Intersect returns a Set containing the items that are present in both Sets.
 func (s Set[T]) Intersect(set2 Set[T]) Set[T] {
 	output := NewSet[T]()
@@ -122,6 +122,19 @@ func ExampleSet_Difference() {
 	// Output: [three]
 }
 
+func ExampleSet_SymmetricDifference() {
+	s := FromSlice([]int{1, 2, 3})
+	s2 := FromSlice([]int{3, 4})
+	fmt.Println(s.SymmetricDifference(s2).Items())
+	// Output: [1 2 4]
+}
+
+func ExampleSet_IsDisjoint() {
+	s := FromSlice([]int{1, 2, 3})
+	fmt.Println(s.IsDisjoint(FromSlice([]int{4, 5})), s.IsDisjoint(FromSlice([]int{3, 4})), s.IsDisjoint(NewSet[int]()))
+	// Output: true false true
+}
+
 func ExampleSet_Union() {
 	s := FromSlice([]string{"one", "two", "one"})
 	s2 := FromSlice([]string{"one", "three"})
@@ -0,0 +1,113 @@
+package set
+
+import "sync"
+
+// Sync is a Set that is safe for concurrent use.
+// Reads share a lock and writes take it exclusively. Functions that iterate over the set
+// work on a snapshot, so they do not hold the lock while calling back into your code
+// and it is safe to change the set from inside them.
+// A Sync must not be copied after it is first used.
+type Sync[T comparable] struct {
+	mu sync.RWMutex
+	s  Set[T]
+}
+
+// NewSync creates a new Sync.
+// If lessFunc is provided, it is used in ordering the set.
+func NewSync[T comparable](lessFunc ...func(T, T) int) *Sync[T] {
+	return &Sync[T]{s: NewSet(lessFunc...)}
+}
+
+// SyncFrom creates a Sync holding a copy of s.
+func SyncFrom[T comparable](s Set[T]) *Sync[T] {
+	return &Sync[T]{s: s.clone()}
+}
+
+// Snapshot returns a copy of the Set as it is now.
+// Changes to the Sync after this do not affect the copy.
+func (s *Sync[T]) Snapshot() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.clone()
+}
+
+// Add adds an item to the Set and reports whether it was added.
+// It returns false if the item was already present.
+func (s *Sync[T]) Add(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s.Contains(item) {
+		return false
+	}
+	s.s.Add(item)
+	return true
+}
+
+// Remove removes an item from the Set and reports whether it was present.
+func (s *Sync[T]) Remove(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.s.Contains(item) {
+		return false
+	}
+	s.s.Remove(item)
+	return true
+}
+
+// CompareAndSwap replaces old with new as one atomic step.
+// It only does so if old is present and new is not, and it reports whether the swap happened.
+func (s *Sync[T]) CompareAndSwap(old, new T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.s.Contains(old) || s.s.Contains(new) {
+		return false
+	}
+	s.s.Remove(old)
+	s.s.Add(new)
+	return true
+}
+
+// Update replaces the Set with the result of applying update to it as one atomic step.
+// update receives a copy, so it may change it and return it.
+// update is called while the set is locked, so it must not use the Sync.
+func (s *Sync[T]) Update(update func(Set[T]) Set[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.s = update(s.s.clone())
+}
+
+// Contains tests whether item is present in the Set.
+func (s *Sync[T]) Contains(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Contains(item)
+}
+
+// Count returns the number of items in the Set.
+func (s *Sync[T]) Count() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Count()
+}
+
+// IsEmpty test whether this is an empty set.
+func (s *Sync[T]) IsEmpty() bool {
+	return s.Count() == 0
+}
+
+// Items returns the items in the Set as a slice.
+func (s *Sync[T]) Items() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Items()
+}
+
+// Iter applies the action to each item in a snapshot of the Set.
+func (s *Sync[T]) Iter(action func(T)) {
+	s.Snapshot().Iter(action)
+}
+
+// Iteri applies the action to each item in a snapshot of the Set, with the index of the item.
+func (s *Sync[T]) Iteri(action func(int, T)) {
+	s.Snapshot().Iteri(action)
+}
@@ -0,0 +1,74 @@
+package set
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func ExampleSync_Add() {
+	s := NewSync[string]()
+	fmt.Println(s.Add("a"), s.Add("a"), s.Count())
+	fmt.Println(s.Remove("a"), s.Remove("a"), s.IsEmpty())
+	// Output:
+	// true false 1
+	// true false true
+}
+
+func ExampleSync_CompareAndSwap() {
+	s := NewSync[string]()
+	s.Add("pending")
+	s.Add("done")
+	fmt.Println(s.CompareAndSwap("pending", "done"))
+	fmt.Println(s.CompareAndSwap("pending", "running"), s.Items())
+	// Output:
+	// false
+	// true [done running]
+}
+
+func ExampleSync_Update() {
+	s := NewSync(func(a, b int) int { return a - b })
+	s.Add(3)
+	s.Update(func(current Set[int]) Set[int] {
+		current.Add(1)
+		current.Add(2)
+		return current
+	})
+	fmt.Println(s.Items())
+	// Output: [1 2 3]
+}
+
+func ExampleSync_Iter() {
+	s := NewSync[int]()
+	s.Add(1)
+	s.Add(2)
+	s.Iter(func(i int) { s.Add(i * 10) })
+	fmt.Println(s.Items())
+	// Output: [1 2 10 20]
+}
+
+func TestSyncConcurrentAdd(t *testing.T) {
+	s := NewSync[int]()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	added := 0
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				if s.Add(i) {
+					mu.Lock()
+					added++
+					mu.Unlock()
+				}
+				s.Contains(i)
+				s.Iter(func(int) {})
+			}
+		}()
+	}
+	wg.Wait()
+	if added != 50 || s.Count() != 50 {
+		t.Fatalf("added = %d, Count() = %d, want 50, 50", added, s.Count())
+	}
+}
@@ -0,0 +1,201 @@
+package set
+
+import (
+	"github.com/flowonyx/functional/eq"
+	"github.com/flowonyx/functional/list"
+	"golang.org/x/exp/slices"
+)
+
+// SetWith is a set like Set whose items are compared with an eq.Hasher instead of ==,
+// so it can hold items that are not comparable, such as slices, or match items in other ways, such as strings without regard to case.
+// It must be created with NewSetWith or FromSliceWith.
+// Copies of a SetWith share the same items, so an item added through one copy is seen by all of them.
+// Use Clone for a SetWith that can be changed on its own.
+type SetWith[T any] struct {
+	*setWith[T]
+}
+
+// setWith holds the state of a SetWith behind a pointer so that its items and buckets never disagree between copies.
+type setWith[T any] struct {
+	hasher  eq.Hasher[T]
+	less    func(T, T) int
+	items   []T
+	buckets map[uint64][]T
+}
+
+// NewSetWith creates a new SetWith that uses hasher to compare items.
+// If lessFunc is provided, it is used in ordering the set.
+func NewSetWith[T any](hasher eq.Hasher[T], lessFunc ...func(T, T) int) SetWith[T] {
+	s := SetWith[T]{&setWith[T]{hasher: hasher, buckets: map[uint64][]T{}}}
+	if len(lessFunc) > 0 {
+		s.less = lessFunc[0]
+	}
+	return s
+}
+
+// FromSliceWith creates a new SetWith that uses hasher to compare items from the items in the given slice.
+// When items are equal, the first is kept.
+func FromSliceWith[T any](hasher eq.Hasher[T], input []T, lessFunc ...func(T, T) int) SetWith[T] {
+	s := NewSetWith(hasher, lessFunc...)
+	for _, i := range input {
+		s.Add(i)
+	}
+	return s
+}
+
+// empty creates an empty SetWith that compares and orders items the same way as s.
+func (s SetWith[T]) empty() SetWith[T] {
+	return SetWith[T]{&setWith[T]{hasher: s.hasher, less: s.less, buckets: map[uint64][]T{}}}
+}
+
+// Clone returns a copy of the SetWith that does not share memory with it.
+func (s SetWith[T]) Clone() SetWith[T] {
+	output := s.empty()
+	output.items = slices.Clone(s.items)
+	for h, bucket := range s.buckets {
+		output.buckets[h] = slices.Clone(bucket)
+	}
+	return output
+}
+
+// Add adds an item to the SetWith. If an equal item already exists in the set, nothing changes.
+func (s *SetWith[T]) Add(item T) {
+	h := s.hasher.Hash(item)
+	if list.ContainsWith(s.hasher, item, s.buckets[h]...) {
+		return
+	}
+	s.buckets[h] = append(s.buckets[h], item)
+	if s.less == nil {
+		s.items = append(s.items, item)
+		return
+	}
+	i, _ := slices.BinarySearchFunc(s.items, item, s.less)
+	s.items = slices.Insert(s.items, i, item)
+}
+
+// Remove removes the item equal to item from the SetWith.
+func (s *SetWith[T]) Remove(item T) {
+	h := s.hasher.Hash(item)
+	equal := func(t T) bool { return s.hasher.Equal(item, t) }
+	i := slices.IndexFunc(s.buckets[h], equal)
+	if i < 0 {
+		return
+	}
+	if bucket := slices.Delete(s.buckets[h], i, i+1); len(bucket) > 0 {
+		s.buckets[h] = bucket
+	} else {
+		delete(s.buckets, h)
+	}
+	s.items = slices.DeleteFunc(s.items, equal)
+}
+
+// Contains tests whether an item equal to item is present in the SetWith.
+func (s SetWith[T]) Contains(item T) bool {
+	return list.ContainsWith(s.hasher, item, s.buckets[s.hasher.Hash(item)]...)
+}
+
+// Exists tests whether any item in the SetWith matches the predicate.
+func (s SetWith[T]) Exists(predicate func(T) bool) bool {
+	return list.Exists(predicate, s.items...)
+}
+
+// ForAll tests whether all items in the SetWith match the predicate.
+func (s SetWith[T]) ForAll(predicate func(T) bool) bool {
+	return list.ForAll(predicate, s.items)
+}
+
+// Count returns the number of items in the SetWith.
+func (s SetWith[T]) Count() int {
+	return len(s.items)
+}
+
+// IsEmpty test whether this is an empty set.
+func (s SetWith[T]) IsEmpty() bool {
+	return s.Count() == 0
+}
+
+// Items returns the items in the SetWith as a slice.
+func (s SetWith[T]) Items() []T {
+	return slices.Clone(s.items)
+}
+
+// ToSlice returns the values in the SetWith as a slice of items.
+func (s SetWith[T]) ToSlice() []T {
+	return s.Items()
+}
+
+// Equal tests if two sets hold equal items.
+func (s SetWith[T]) Equal(s2 SetWith[T]) bool {
+	return s.Count() == s2.Count() && s.IsSubsetOf(s2)
+}
+
+// IsSubsetOf tests whether every item in this SetWith is also in potentialSuperset.
+// An empty set is a subset of any other set and equal sets are subsets of each other.
+func (s SetWith[T]) IsSubsetOf(potentialSuperset SetWith[T]) bool {
+	return s.ForAll(potentialSuperset.Contains)
+}
+
+// IsSupersetOf tests whether every item in potentialSubset is also in this SetWith.
+func (s SetWith[T]) IsSupersetOf(potentialSubset SetWith[T]) bool {
+	return potentialSubset.IsSubsetOf(s)
+}
+
+// IsDisjoint tests whether the sets have no items in common.
+func (s SetWith[T]) IsDisjoint(set2 SetWith[T]) bool {
+	return !s.Exists(set2.Contains)
+}
+
+// Filter returns a SetWith that contains only items from this SetWith that match the predicate.
+func (s SetWith[T]) Filter(predicate func(T) bool) SetWith[T] {
+	output := s.empty()
+	for _, i := range s.items {
+		if predicate(i) {
+			output.Add(i)
+		}
+	}
+	return output
+}
+
+// Union returns a SetWith that contains all items that are present in either set.
+// It compares items in the way this SetWith does.
+func (s SetWith[T]) Union(set2 SetWith[T]) SetWith[T] {
+	output := s.Clone()
+	set2.Iter(output.Add)
+	return output
+}
+
+// Intersect returns a SetWith containing the items of this SetWith that are also present in set2.
+func (s SetWith[T]) Intersect(set2 SetWith[T]) SetWith[T] {
+	return s.Filter(set2.Contains)
+}
+
+// Difference returns a SetWith containing the items that are only present in one set or the other.
+// As with Set.Difference, this is the symmetric difference and is the same as SymmetricDifference.
+func (s SetWith[T]) Difference(set2 SetWith[T]) SetWith[T] {
+	output := s.Filter(func(t T) bool { return !set2.Contains(t) })
+	set2.Iter(func(t T) {
+		if !s.Contains(t) {
+			output.Add(t)
+		}
+	})
+	return output
+}
+
+// SymmetricDifference returns a SetWith containing the items that are only present in one set or the other.
+func (s SetWith[T]) SymmetricDifference(set2 SetWith[T]) SetWith[T] {
+	return s.Difference(set2)
+}
+
+// Iter applies the action to each item in the SetWith.
+func (s SetWith[T]) Iter(action func(T)) {
+	for _, i := range s.items {
+		action(i)
+	}
+}
+
+// MapWith applies the mapping function to each item in s and returns a SetWith of the results that uses hasher to compare them.
+func MapWith[T, R any](hasher eq.Hasher[R], mapping func(T) R, s SetWith[T]) SetWith[R] {
+	output := NewSetWith(hasher)
+	s.Iter(func(t T) { output.Add(mapping(t)) })
+	return output
+}
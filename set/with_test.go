@@ -0,0 +1,66 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/flowonyx/functional/eq"
+)
+
+func ExampleNewSetWith() {
+	s := NewSetWith(eq.Slice(eq.Integer[int]()))
+	s.Add([]int{1, 2})
+	s.Add([]int{3})
+	s.Add([]int{1, 2})
+	fmt.Println(s.Items(), s.Contains([]int{3}), s.Contains([]int{2, 1}))
+	s.Remove([]int{1, 2})
+	fmt.Println(s.Items())
+	// Output:
+	// [[1 2] [3]] true false
+	// [[3]]
+}
+
+func ExampleFromSliceWith() {
+	s := FromSliceWith(eq.Folded[string](), []string{"Go", "zig", "GO", "Rust"}, strings.Compare)
+	fmt.Println(s.Items(), s.Count())
+	// Output: [Go Rust zig] 3
+}
+
+func ExampleSetWith_Union() {
+	tags := eq.Folded[string]()
+	s := FromSliceWith(tags, []string{"Go", "Zig"})
+	s2 := FromSliceWith(tags, []string{"go", "Rust"})
+	fmt.Println(s.Union(s2).Items())
+	fmt.Println(s.Intersect(s2).Items())
+	fmt.Println(s.SymmetricDifference(s2).Items())
+	fmt.Println(s.IsDisjoint(s2), s.Equal(FromSliceWith(tags, []string{"zig", "GO"})))
+	// Output:
+	// [Go Zig Rust]
+	// [Go]
+	// [Zig Rust]
+	// false true
+}
+
+func ExampleMapWith() {
+	s := FromSliceWith(eq.Integer[int](), []int{1, 2, 3})
+	r := MapWith(eq.Slice(eq.Integer[int]()), func(i int) []int { return []int{i % 2} }, s)
+	fmt.Println(r.Items())
+	// Output: [[1] [0]]
+}
+
+func TestSetWithCopiesShareItems(t *testing.T) {
+	s := NewSetWith(eq.Folded[string]())
+	s.Add("go")
+	c := s
+	c.Add("Rust")
+	c.Remove("GO")
+	if s.Contains("go") || !s.Contains("rust") || s.Count() != 1 || len(s.Items()) != 1 {
+		t.Errorf("changes through a copy were not seen consistently: Items %v, Count %d", s.Items(), s.Count())
+	}
+	clone := s.Clone()
+	clone.Add("zig")
+	if s.Contains("zig") || s.Count() != 1 {
+		t.Errorf("adding to a clone changed the original: %v", s.Items())
+	}
+}
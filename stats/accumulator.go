@@ -0,0 +1,108 @@
+package stats
+
+import "math"
+
+// Accumulator computes running statistics for values fed to it one at a time,
+// so the values never need to be kept in memory.
+// The zero value is an empty Accumulator ready to use.
+type Accumulator[T numeric] struct {
+	count    int
+	mean     float64
+	m2       float64
+	sum      T
+	min, max T
+}
+
+// NewAccumulator creates an empty Accumulator.
+func NewAccumulator[T numeric]() *Accumulator[T] {
+	return &Accumulator[T]{}
+}
+
+// Add feeds values into the Accumulator.
+func (a *Accumulator[T]) Add(values ...T) {
+	for _, v := range values {
+		if a.count == 0 || v < a.min {
+			a.min = v
+		}
+		if a.count == 0 || v > a.max {
+			a.max = v
+		}
+		a.count++
+		a.sum += v
+		// Welford's algorithm
+		delta := float64(v) - a.mean
+		a.mean += delta / float64(a.count)
+		a.m2 += delta * (float64(v) - a.mean)
+	}
+}
+
+// Merge combines the statistics of other into a, as if every value given to other had been given to a.
+// This allows separate parts of the data to be accumulated concurrently.
+func (a *Accumulator[T]) Merge(other *Accumulator[T]) {
+	if other.count == 0 {
+		return
+	}
+	if a.count == 0 {
+		*a = *other
+		return
+	}
+	n := float64(a.count + other.count)
+	delta := other.mean - a.mean
+	a.m2 += other.m2 + delta*delta*float64(a.count)*float64(other.count)/n
+	a.mean += delta * float64(other.count) / n
+	a.count += other.count
+	a.sum += other.sum
+	a.min = min(a.min, other.min)
+	a.max = max(a.max, other.max)
+}
+
+// Count returns the number of values added.
+func (a *Accumulator[T]) Count() int {
+	return a.count
+}
+
+// Sum returns the sum of the values added.
+func (a *Accumulator[T]) Sum() T {
+	return a.sum
+}
+
+// Min returns the smallest value added, or the zero value if none have been.
+func (a *Accumulator[T]) Min() T {
+	return a.min
+}
+
+// Max returns the largest value added, or the zero value if none have been.
+func (a *Accumulator[T]) Max() T {
+	return a.max
+}
+
+// Mean returns the mean of the values added, or 0 if none have been.
+func (a *Accumulator[T]) Mean() float64 {
+	return a.mean
+}
+
+// PopulationVariance returns the population variance of the values added, or 0 if none have been.
+func (a *Accumulator[T]) PopulationVariance() float64 {
+	if a.count == 0 {
+		return 0
+	}
+	return a.m2 / float64(a.count)
+}
+
+// SampleVariance returns the sample variance of the values added, or 0 if fewer than 2 have been.
+func (a *Accumulator[T]) SampleVariance() float64 {
+	if a.count < 2 {
+		return 0
+	}
+	return a.m2 / float64(a.count-1)
+}
+
+// PopulationStdDev returns the population standard deviation of the values added.
+func (a *Accumulator[T]) PopulationStdDev() float64 {
+	return math.Sqrt(a.PopulationVariance())
+}
+
+// SampleStdDev returns the sample standard deviation of the values added.
+func (a *Accumulator[T]) SampleStdDev() float64 {
+	return math.Sqrt(a.SampleVariance())
+}
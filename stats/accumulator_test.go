@@ -0,0 +1,26 @@
+package stats_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/stats"
+)
+
+func ExampleAccumulator() {
+	a := stats.NewAccumulator[int]()
+	for _, v := range []int{2, 4, 4, 4} {
+		a.Add(v)
+	}
+	a.Add(5, 5, 7, 9)
+	fmt.Println(a.Count(), a.Sum(), a.Min(), a.Max(), a.Mean(), a.PopulationStdDev())
+	// Output: 8 40 2 9 5 2
+}
+
+func ExampleAccumulator_Merge() {
+	a, b := stats.NewAccumulator[float64](), stats.NewAccumulator[float64]()
+	a.Add(2, 4, 4, 4)
+	b.Add(5, 5, 7, 9)
+	a.Merge(b)
+	fmt.Println(a.Count(), a.Mean(), a.PopulationVariance(), a.Min(), a.Max())
+	// Output: 8 5 4 2 9
+}
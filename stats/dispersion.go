@@ -0,0 +1,153 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+func accumulate[T numeric](values []T) *Accumulator[T] {
+	a := NewAccumulator[T]()
+	a.Add(values...)
+	return a
+}
+
+// PopulationVariance returns the variance of values treating them as the whole population (dividing by n).
+// It uses Welford's algorithm, which stays accurate when the values are large compared to their spread.
+func PopulationVariance[T numeric](values ...T) (float64, error) {
+	if len(values) == 0 {
+		return 0, emptyErr("PopulationVariance")
+	}
+	return accumulate(values).PopulationVariance(), nil
+}
+
+// SampleVariance returns the variance of values treating them as a sample of a larger population (dividing by n-1).
+// At least two values are required.
+func SampleVariance[T numeric](values ...T) (float64, error) {
+	if len(values) < 2 {
+		return 0, fmt.Errorf("%w: SampleVariance needs at least 2 values", errors.BadArgumentErr)
+	}
+	return accumulate(values).SampleVariance(), nil
+}
+
+// PopulationStdDev returns the standard deviation of values treating them as the whole population.
+func PopulationStdDev[T numeric](values ...T) (float64, error) {
+	v, err := PopulationVariance(values...)
+	return math.Sqrt(v), err
+}
+
+// SampleStdDev returns the standard deviation of values treating them as a sample of a larger population.
+func SampleStdDev[T numeric](values ...T) (float64, error) {
+	v, err := SampleVariance(values...)
+	return math.Sqrt(v), err
+}
+
+// PopulationVarianceBy applies projection to each value and returns the population variance of the results.
+func PopulationVarianceBy[T any, R numeric](projection func(T) R, values ...T) (float64, error) {
+	return PopulationVariance(list.Map(projection, values)...)
+}
+
+// SampleVarianceBy applies projection to each value and returns the sample variance of the results.
+func SampleVarianceBy[T any, R numeric](projection func(T) R, values ...T) (float64, error) {
+	return SampleVariance(list.Map(projection, values)...)
+}
+
+// PopulationStdDevBy applies projection to each value and returns the population standard deviation of the results.
+func PopulationStdDevBy[T any, R numeric](projection func(T) R, values ...T) (float64, error) {
+	return PopulationStdDev(list.Map(projection, values)...)
+}
+
+// SampleStdDevBy applies projection to each value and returns the sample standard deviation of the results.
+func SampleStdDevBy[T any, R numeric](projection func(T) R, values ...T) (float64, error) {
+	return SampleStdDev(list.Map(projection, values)...)
+}
+
+// ZScore returns how many population standard deviations each value is from the mean.
+// It returns a BadArgumentErr if values is empty or all values are equal.
+func ZScore[T numeric](values ...T) ([]float64, error) {
+	if len(values) == 0 {
+		return nil, emptyErr("ZScore")
+	}
+	a := accumulate(values)
+	sd := a.PopulationStdDev()
+	if sd == 0 {
+		return nil, fmt.Errorf("%w: ZScore is undefined when the standard deviation is 0", errors.BadArgumentErr)
+	}
+	mean := a.Mean()
+	return list.Map(func(v T) float64 { return (float64(v) - mean) / sd }, values), nil
+}
+
+// ZScoreBy applies projection to each value and returns the z-scores of the results.
+func ZScoreBy[T any, R numeric](projection func(T) R, values ...T) ([]float64, error) {
+	return ZScore(list.Map(projection, values)...)
+}
+
+// covariance returns the co-moment of xs and ys with the means of each, using a one pass algorithm.
+func covariance[T numeric](name string, minLen int, xs, ys []T) (comoment, meanX, meanY float64, err error) {
+	if len(xs) != len(ys) {
+		return 0, 0, 0, fmt.Errorf("%w: %s needs slices of the same length, got %d and %d", errors.BadArgumentErr, name, len(xs), len(ys))
+	}
+	if len(xs) < minLen {
+		return 0, 0, 0, fmt.Errorf("%w: %s needs at least %d values", errors.BadArgumentErr, name, minLen)
+	}
+	list.Iteri2(func(i int, x, y T) {
+		n := float64(i + 1)
+		dx := float64(x) - meanX
+		meanX += dx / n
+		meanY += (float64(y) - meanY) / n
+		comoment += dx * (float64(y) - meanY)
+	}, xs, ys)
+	return comoment, meanX, meanY, nil
+}
+
+// PopulationCovariance returns the covariance of xs and ys treating them as the whole population.
+// xs and ys must have the same length.
+func PopulationCovariance[T numeric](xs, ys []T) (float64, error) {
+	c, _, _, err := covariance("PopulationCovariance", 1, xs, ys)
+	if err != nil {
+		return 0, err
+	}
+	return c / float64(len(xs)), nil
+}
+
+// SampleCovariance returns the covariance of xs and ys treating them as a sample of a larger population.
+// xs and ys must have the same length of at least 2.
+func SampleCovariance[T numeric](xs, ys []T) (float64, error) {
+	c, _, _, err := covariance("SampleCovariance", 2, xs, ys)
+	if err != nil {
+		return 0, err
+	}
+	return c / float64(len(xs)-1), nil
+}
+
+// PopulationCovarianceBy applies the projections to each value and returns the population covariance of the results.
+func PopulationCovarianceBy[T any, R numeric](projectionX, projectionY func(T) R, values ...T) (float64, error) {
+	return PopulationCovariance(list.Map(projectionX, values), list.Map(projectionY, values))
+}
+
+// SampleCovarianceBy applies the projections to each value and returns the sample covariance of the results.
+func SampleCovarianceBy[T any, R numeric](projectionX, projectionY func(T) R, values ...T) (float64, error) {
+	return SampleCovariance(list.Map(projectionX, values), list.Map(projectionY, values))
+}
+
+// Correlation returns the Pearson correlation coefficient of xs and ys, between -1 and 1.
+// It returns a BadArgumentErr if either slice has no variation.
+func Correlation[T numeric](xs, ys []T) (float64, error) {
+	c, _, _, err := covariance("Correlation", 2, xs, ys)
+	if err != nil {
+		return 0, err
+	}
+	vx, vy := accumulate(xs).PopulationVariance(), accumulate(ys).PopulationVariance()
+	if vx == 0 || vy == 0 {
+		return 0, fmt.Errorf("%w: Correlation is undefined when a variance is 0", errors.BadArgumentErr)
+	}
+	n := float64(len(xs))
+	return c / n / math.Sqrt(vx*vy), nil
+}
+
+// CorrelationBy applies the projections to each value and returns the Pearson correlation coefficient of the results.
+func CorrelationBy[T any, R numeric](projectionX, projectionY func(T) R, values ...T) (float64, error) {
+	return Correlation(list.Map(projectionX, values), list.Map(projectionY, values))
+}
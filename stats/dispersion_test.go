@@ -0,0 +1,64 @@
+package stats_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/stats"
+)
+
+func ExamplePopulationVariance() {
+	values := []int{2, 4, 4, 4, 5, 5, 7, 9}
+	pv, _ := stats.PopulationVariance(values...)
+	psd, _ := stats.PopulationStdDev(values...)
+	sv, _ := stats.SampleVariance(values...)
+	fmt.Printf("%.4f %.4f %.4f", pv, psd, sv)
+	// Output: 4.0000 2.0000 4.5714
+}
+
+func ExampleSampleVariance() {
+	// large offsets do not lose precision because the calculation is numerically stable
+	v, _ := stats.SampleVariance(1e9+4, 1e9+7, 1e9+13, 1e9+16)
+	_, err := stats.SampleVariance(1.0)
+	fmt.Println(v, err)
+	// Output: 30 bad argument: SampleVariance needs at least 2 values
+}
+
+func ExampleSampleStdDevBy() {
+	sd, _ := stats.SampleStdDevBy(func(s sale) float64 { return s.Amount }, sales...)
+	fmt.Printf("%.3f", sd)
+	// Output: 12.910
+}
+
+func ExampleZScore() {
+	z, _ := stats.ZScore(2, 4, 4, 4, 5, 5, 7, 9)
+	fmt.Println(z)
+	// Output: [-1.5 -0.5 -0.5 -0.5 0 0 1 2]
+}
+
+func ExamplePopulationCovariance() {
+	xs := []float64{1, 2, 3, 4}
+	ys := []float64{2, 4, 6, 8}
+	pc, _ := stats.PopulationCovariance(xs, ys)
+	sc, _ := stats.SampleCovariance(xs, ys)
+	fmt.Printf("%.4f %.4f", pc, sc)
+	// Output: 2.5000 3.3333
+}
+
+func ExampleCorrelation() {
+	c, _ := stats.Correlation([]int{1, 2, 3, 4}, []int{8, 6, 4, 2})
+	_, err := stats.Correlation([]int{1, 2}, []int{1})
+	fmt.Println(c, err)
+	// Output: -1 bad argument: Correlation needs slices of the same length, got 2 and 1
+}
+
+func ExampleCorrelationBy() {
+	type point struct{ x, y float64 }
+	points := []point{{1, 2}, {2, 4}, {3, 6}, {4, 8}}
+	x := func(p point) float64 { return p.x }
+	y := func(p point) float64 { return p.y }
+	pc, _ := stats.PopulationCovarianceBy(x, y, points...)
+	sc, _ := stats.SampleCovarianceBy(x, y, points...)
+	c, _ := stats.CorrelationBy(x, y, points...)
+	fmt.Printf("%.4f %.4f %g", pc, sc, c)
+	// Output: 2.5000 3.3333 1
+}
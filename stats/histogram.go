@@ -0,0 +1,54 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+// Bin is one bar of a Histogram. It counts the values from Low up to but not including High,
+// except for the last bin, which also includes High.
+type Bin struct {
+	Low, High float64
+	Count     int
+}
+
+// Histogram divides the range from the smallest to the largest value into bins of equal width
+// and counts how many values fall into each.
+// It returns a BadArgumentErr if any value is NaN or infinite, or if the range is too large for a float64.
+func Histogram[T numeric](bins int, values ...T) ([]Bin, error) {
+	if len(values) == 0 {
+		return nil, emptyErr("Histogram")
+	}
+	if bins < 1 {
+		return nil, fmt.Errorf("%w: Histogram(%d) needs at least 1 bin", errors.BadArgumentErr, bins)
+	}
+	f := toFloats(values)
+	if i := list.IndexBy(func(v float64) bool { return math.IsNaN(v) || math.IsInf(v, 0) }, f); i >= 0 {
+		return nil, fmt.Errorf("%w: Histogram needs finite values, got %g at index %d", errors.BadArgumentErr, f[i], i)
+	}
+	lo, hi := list.MustMin(f...), list.MustMax(f...)
+	if math.IsInf(hi-lo, 0) {
+		return nil, fmt.Errorf("%w: Histogram cannot divide the range from %g to %g into bins", errors.BadArgumentErr, lo, hi)
+	}
+	width := (hi - lo) / float64(bins)
+	output := list.InitSlice(bins, func(i int) Bin {
+		return Bin{Low: lo + width*float64(i), High: lo + width*float64(i+1)}
+	})
+	output[bins-1].High = hi
+	for _, v := range f {
+		i := bins - 1
+		if width > 0 {
+			i = min(int((v-lo)/width), bins-1)
+		}
+		output[i].Count++
+	}
+	return output, nil
+}
+
+// HistogramBy applies projection to each value and returns the histogram of the results.
+func HistogramBy[T any, R numeric](bins int, projection func(T) R, values ...T) ([]Bin, error) {
+	return Histogram(bins, list.Map(projection, values)...)
+}
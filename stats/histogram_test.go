@@ -0,0 +1,32 @@
+package stats_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/flowonyx/functional/stats"
+)
+
+func ExampleHistogram() {
+	bins, _ := stats.Histogram(3, 1, 2, 2, 3, 4, 5, 7)
+	for _, b := range bins {
+		fmt.Printf("[%g, %g): %d\n", b.Low, b.High, b.Count)
+	}
+	// Output:
+	// [1, 3): 3
+	// [3, 5): 2
+	// [5, 7): 2
+}
+
+func ExampleHistogram_nonFinite() {
+	_, err := stats.Histogram(2, 1, math.Inf(-1), 3)
+	fmt.Println(err)
+	_, err = stats.Histogram(2, 1, math.NaN())
+	fmt.Println(err)
+	_, err = stats.Histogram(2, -math.MaxFloat64, math.MaxFloat64)
+	fmt.Println(err)
+	// Output:
+	// bad argument: Histogram needs finite values, got -Inf at index 1
+	// bad argument: Histogram needs finite values, got NaN at index 1
+	// bad argument: Histogram cannot divide the range from -1.7976931348623157e+308 to 1.7976931348623157e+308 into bins
+}
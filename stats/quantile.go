@@ -0,0 +1,81 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+// Interpolation chooses how Percentile and Quantiles find a value that falls between two data points.
+type Interpolation int
+
+const (
+	// Linear interpolates between the two nearest data points.
+	Linear Interpolation = iota
+	// Lower uses the lower of the two nearest data points.
+	Lower
+	// Higher uses the higher of the two nearest data points.
+	Higher
+	// Nearest uses whichever of the two nearest data points is closer, rounding halves to even.
+	Nearest
+	// Midpoint uses the mean of the two nearest data points.
+	Midpoint
+)
+
+// Percentile returns the value below which p percent of values fall.
+// p must be between 0 and 100. For example, Percentile(50, Linear, values...) is the median.
+func Percentile[T numeric](p float64, method Interpolation, values ...T) (float64, error) {
+	if len(values) == 0 {
+		return 0, emptyErr("Percentile")
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, fmt.Errorf("%w: Percentile(%v) must be between 0 and 100", errors.BadArgumentErr, p)
+	}
+	return percentileOfSorted(sortedFloats(values), p/100, method), nil
+}
+
+// PercentileBy applies projection to each value and returns the percentile of the results.
+func PercentileBy[T any, R numeric](p float64, method Interpolation, projection func(T) R, values ...T) (float64, error) {
+	return Percentile(p, method, list.Map(projection, values)...)
+}
+
+// Quantiles divides values into n groups of equal probability and returns the n-1 cut points between them.
+// For example, Quantiles(4, Linear, values...) returns the three quartiles.
+func Quantiles[T numeric](n int, method Interpolation, values ...T) ([]float64, error) {
+	if len(values) == 0 {
+		return nil, emptyErr("Quantiles")
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("%w: Quantiles(%d) needs at least 1 group", errors.BadArgumentErr, n)
+	}
+	s := sortedFloats(values)
+	return list.InitSlice(n-1, func(i int) float64 {
+		return percentileOfSorted(s, float64(i+1)/float64(n), method)
+	}), nil
+}
+
+// QuantilesBy applies projection to each value and returns the quantiles of the results.
+func QuantilesBy[T any, R numeric](n int, method Interpolation, projection func(T) R, values ...T) ([]float64, error) {
+	return Quantiles(n, method, list.Map(projection, values)...)
+}
+
+// percentileOfSorted finds the value at fraction q (0 to 1) through sorted.
+func percentileOfSorted(sorted []float64, q float64, method Interpolation) float64 {
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	frac := pos - float64(lo)
+	switch method {
+	case Lower:
+		return sorted[lo]
+	case Higher:
+		return sorted[hi]
+	case Nearest:
+		return sorted[int(math.RoundToEven(pos))]
+	case Midpoint:
+		return (sorted[lo] + sorted[hi]) / 2
+	}
+	return sorted[lo] + (sorted[hi]-sorted[lo])*frac
+}
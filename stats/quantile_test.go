@@ -0,0 +1,28 @@
+package stats_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/stats"
+)
+
+func ExamplePercentile() {
+	values := []int{1, 2, 3, 4}
+	for _, method := range []stats.Interpolation{stats.Linear, stats.Lower, stats.Higher, stats.Nearest, stats.Midpoint} {
+		p, _ := stats.Percentile(40, method, values...)
+		fmt.Print(p, " ")
+	}
+	// Output: 2.2 2 3 2 2.5
+}
+
+func ExampleQuantiles() {
+	q, _ := stats.Quantiles(4, stats.Linear, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	fmt.Println(q)
+	// Output: [3 5 7]
+}
+
+func ExamplePercentileBy() {
+	p, _ := stats.PercentileBy(100, stats.Linear, func(s sale) float64 { return s.Amount }, sales...)
+	fmt.Println(p)
+	// Output: 40
+}
@@ -0,0 +1,84 @@
+// Package stats provides descriptive statistics for slices of numbers.
+// Functions that end with By apply a projection to each value to get the number to use.
+// Functions that cannot produce a result, such as the median of an empty slice, return a BadArgumentErr.
+package stats
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+type numeric interface {
+	constraints.Float | constraints.Integer
+}
+
+func toFloats[T numeric](values []T) []float64 {
+	return list.Map(func(v T) float64 { return float64(v) }, values)
+}
+
+func sortedFloats[T numeric](values []T) []float64 {
+	s := toFloats(values)
+	slices.Sort(s)
+	return s
+}
+
+func emptyErr(name string) error {
+	return fmt.Errorf("%w: %s needs at least one value", errors.BadArgumentErr, name)
+}
+
+// Median returns the middle value of values once they are sorted.
+// If there is an even number of values, it returns the mean of the two middle values.
+func Median[T numeric](values ...T) (float64, error) {
+	if len(values) == 0 {
+		return 0, emptyErr("Median")
+	}
+	s := sortedFloats(values)
+	mid := len(s) / 2
+	if len(s)%2 == 0 {
+		return (s[mid-1] + s[mid]) / 2, nil
+	}
+	return s[mid], nil
+}
+
+// MedianBy applies projection to each value and returns the median of the results.
+func MedianBy[T any, R numeric](projection func(T) R, values ...T) (float64, error) {
+	return Median(list.Map(projection, values)...)
+}
+
+// Mode returns the values that occur most often, in the order they first appear.
+func Mode[T comparable](values ...T) ([]T, error) {
+	if len(values) == 0 {
+		return nil, emptyErr("Mode")
+	}
+	counts := list.CountBy(func(t T) T { return t }, values...)
+	most := 0
+	for _, c := range counts {
+		most = max(most, c)
+	}
+	return list.Filter(func(t T) bool { return counts[t] == most }, list.Distinct(values...)...), nil
+}
+
+// ModeBy applies projection to each value and returns the results that occur most often, in the order they first appear.
+func ModeBy[T any, Key comparable](projection func(T) Key, values ...T) ([]Key, error) {
+	return Mode(list.Map(projection, values)...)
+}
+
+// Mean returns the arithmetic mean of values as a float64.
+// Unlike list.Average, it does not truncate integer results or panic when values is empty.
+func Mean[T numeric](values ...T) (float64, error) {
+	if len(values) == 0 {
+		return 0, emptyErr("Mean")
+	}
+	a := NewAccumulator[T]()
+	a.Add(values...)
+	return a.Mean(), nil
+}
+
+// MeanBy applies projection to each value and returns the mean of the results.
+func MeanBy[T any, R numeric](projection func(T) R, values ...T) (float64, error) {
+	return Mean(list.Map(projection, values)...)
+}
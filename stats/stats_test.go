@@ -0,0 +1,46 @@
+package stats_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/stats"
+)
+
+type sale struct {
+	Region string
+	Amount float64
+}
+
+var sales = []sale{{"north", 10}, {"south", 30}, {"north", 20}, {"east", 40}}
+
+func ExampleMedian() {
+	m, _ := stats.Median(3, 1, 2)
+	m2, _ := stats.Median(4, 1, 3, 2)
+	_, err := stats.Median[int]()
+	fmt.Println(m, m2, err)
+	// Output: 2 2.5 bad argument: Median needs at least one value
+}
+
+func ExampleMedianBy() {
+	m, _ := stats.MedianBy(func(s sale) float64 { return s.Amount }, sales...)
+	fmt.Println(m)
+	// Output: 25
+}
+
+func ExampleMode() {
+	m, _ := stats.Mode(1, 2, 2, 3, 3, 4)
+	fmt.Println(m)
+	// Output: [2 3]
+}
+
+func ExampleModeBy() {
+	m, _ := stats.ModeBy(func(s sale) string { return s.Region }, sales...)
+	fmt.Println(m)
+	// Output: [north]
+}
+
+func ExampleMean() {
+	m, _ := stats.Mean(1, 2)
+	fmt.Println(m)
+	// Output: 1.5
+}
@@ -0,0 +1,137 @@
+package strings
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/flowonyx/functional/list"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/search"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CollateOption changes how a Collator compares strings.
+type CollateOption = collate.Option
+
+// Options that can be passed to NewCollator.
+var (
+	// CollateIgnoreCase compares strings without regard to case.
+	CollateIgnoreCase = collate.IgnoreCase
+	// CollateIgnoreDiacritics compares strings without regard to accents, so "e" equals "é".
+	CollateIgnoreDiacritics = collate.IgnoreDiacritics
+	// CollateIgnoreWidth treats full-width and half-width forms as equal.
+	CollateIgnoreWidth = collate.IgnoreWidth
+	// CollateLoose ignores case, diacritics and width.
+	CollateLoose = collate.Loose
+	// CollateNumeric compares runs of digits by their numeric value, so "file2" sorts before "file10".
+	CollateNumeric = collate.Numeric
+)
+
+// Collator compares strings using the rules of a language, so accented and
+// mixed case names sort the way readers of that language expect.
+// It is safe for concurrent use.
+type Collator struct {
+	mu sync.Mutex
+	c  *collate.Collator
+}
+
+// NewCollator creates a Collator for the language identified by tag.
+func NewCollator(tag language.Tag, opts ...CollateOption) *Collator {
+	return &Collator{c: collate.New(tag, opts...)}
+}
+
+// Compare returns an integer comparing a and b according to the language of the Collator.
+// The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
+func (c *Collator) Compare(a, b string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.c.CompareString(a, b)
+}
+
+// CompareFunc returns the Compare method of c for any string type.
+// It can be passed directly to list.SortWith.
+func CompareFunc[TString ~string](c *Collator) func(TString, TString) int {
+	return func(a, b TString) int {
+		return c.Compare(string(a), string(b))
+	}
+}
+
+// SortLocale returns a clone of values sorted according to the rules of the language identified by tag.
+func SortLocale[TString ~string](tag language.Tag, values []TString, opts ...CollateOption) []TString {
+	return list.SortWith(CompareFunc[TString](NewCollator(tag, opts...)), values)
+}
+
+// NFC returns s in Unicode Normalization Form C (canonical composition).
+func NFC[TString ~string](s TString) TString {
+	return TString(norm.NFC.String(string(s)))
+}
+
+// NFD returns s in Unicode Normalization Form D (canonical decomposition).
+func NFD[TString ~string](s TString) TString {
+	return TString(norm.NFD.String(string(s)))
+}
+
+// NFKC returns s in Unicode Normalization Form KC (compatibility composition).
+func NFKC[TString ~string](s TString) TString {
+	return TString(norm.NFKC.String(string(s)))
+}
+
+// NFKD returns s in Unicode Normalization Form KD (compatibility decomposition).
+func NFKD[TString ~string](s TString) TString {
+	return TString(norm.NFKD.String(string(s)))
+}
+
+// RemoveDiacritics returns s with accents and other combining marks removed, so "Crème brûlée" becomes "Creme brulee".
+// Letters that are not built from a base letter and a mark, such as "ø" or "ß", are left alone.
+func RemoveDiacritics[TString ~string](s TString) TString {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	r, _, err := transform.String(t, string(s))
+	if err != nil {
+		return s
+	}
+	return TString(r)
+}
+
+// Slugify converts s into a lower case, URL friendly form such as "creme-brulee-recipe".
+// Diacritics are removed and every run of characters that are not letters or digits becomes a single hyphen.
+func Slugify[TString ~string](s TString) TString {
+	output := &strings.Builder{}
+	pendingHyphen := false
+	for _, r := range string(RemoveDiacritics(Lower(s))) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingHyphen && output.Len() > 0 {
+				output.WriteRune('-')
+			}
+			pendingHyphen = false
+			output.WriteRune(r)
+		} else {
+			pendingHyphen = true
+		}
+	}
+	return TString(output.String())
+}
+
+// IndexFoldSpecial returns the byte index of the first match of substr in s, or -1 if there is none,
+// comparing without regard to case according to the rules of the language identified by tag.
+// The end of the match is also returned because it may differ in length from substr.
+func IndexFoldSpecial[TString1, TString2 ~string](tag language.Tag, s TString1, substr TString2) (start, end int) {
+	return search.New(tag, search.IgnoreCase).IndexString(string(s), string(substr))
+}
+
+// ContainsFoldSpecial reports whether substr is within s, comparing without regard to case
+// according to the rules of the language identified by tag.
+func ContainsFoldSpecial[TString1, TString2 ~string](tag language.Tag, s TString1, substr TString2) bool {
+	start, _ := IndexFoldSpecial(tag, s, substr)
+	return start >= 0
+}
+
+// EqualFoldSpecial reports whether s and t are equal without regard to case
+// according to the rules of the language identified by tag.
+func EqualFoldSpecial[TString1, TString2 ~string](tag language.Tag, s TString1, t TString2) bool {
+	return search.New(tag, search.IgnoreCase).EqualString(string(s), string(t))
+}
@@ -0,0 +1,62 @@
+package strings
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/list"
+	"golang.org/x/text/language"
+)
+
+func ExampleCollator_Compare() {
+	c := NewCollator(language.French)
+	fmt.Println(c.Compare("éclair", "ecrire"), Compare("éclair", "ecrire"))
+	// Output: -1 1
+}
+
+func ExampleCompareFunc() {
+	names := []string{"Zoë", "zebra", "Émile", "adam", "Eve"}
+	fmt.Println(list.SortWith(CompareFunc[string](NewCollator(language.English)), names))
+	fmt.Println(list.Sort(names))
+	// Output:
+	// [adam Émile Eve zebra Zoë]
+	// [Eve Zoë adam zebra Émile]
+}
+
+func ExampleSortLocale() {
+	files := []string{"file10", "file2", "File1"}
+	fmt.Println(SortLocale(language.English, files, CollateNumeric))
+	// Output: [File1 file2 file10]
+}
+
+func ExampleSortLocale_swedish() {
+	words := []string{"ö", "z", "a", "ä"}
+	fmt.Println(SortLocale(language.Swedish, words), SortLocale(language.German, words))
+	// Output: [a z ä ö] [a ä ö z]
+}
+
+func ExampleNFC() {
+	decomposed := "é"
+	fmt.Println(len(decomposed), len(NFC(decomposed)), len(NFD(NFC(decomposed))), NFKC("ﬁ"))
+	// Output: 3 2 3 fi
+}
+
+func ExampleRemoveDiacritics() {
+	fmt.Println(RemoveDiacritics("Crème brûlée à São Paulo"))
+	// Output: Creme brulee a Sao Paulo
+}
+
+func ExampleSlugify() {
+	fmt.Println(Slugify("  Crème Brûlée: the Recipe!  "))
+	// Output: creme-brulee-the-recipe
+}
+
+func ExampleContainsFoldSpecial() {
+	start, end := IndexFoldSpecial(language.German, "Die STRAẞE entlang", "straße")
+	fmt.Println(ContainsFoldSpecial(language.English, "Hello World", "WORLD"), start, end)
+	// Output: true 4 12
+}
+
+func ExampleEqualFoldSpecial() {
+	fmt.Println(EqualFoldSpecial(language.Turkish, "İstanbul", "istanbul"), EqualFoldSpecial(language.English, "Go", "GO"))
+	// Output: true true
+}
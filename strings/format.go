@@ -0,0 +1,238 @@
+package strings
+
+import (
+	"fmt"
+	"html"
+	"reflect"
+	"strings"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+)
+
+// Escaper is applied to each value substituted into a template by Format.
+// It is not applied to the text of the template itself.
+type Escaper func(string) string
+
+// HTMLEscaper escapes the special HTML characters <, >, &, ' and ".
+func HTMLEscaper(s string) string {
+	return html.EscapeString(s)
+}
+
+// ShellEscaper quotes s so that a POSIX shell treats it as a single word.
+func ShellEscaper(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// SQLLiteralEscaper quotes s as a SQL string literal, doubling any single quotes.
+func SQLLiteralEscaper(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// MissingKeysError is returned by Format in strict mode when
+// placeholders without defaults have no value.
+// It wraps KeyNotFoundErr so it can be checked with errors.Is.
+type MissingKeysError struct {
+	Keys []string
+}
+
+func (e MissingKeysError) Error() string {
+	return fmt.Sprintf("%v: %s", errors.KeyNotFoundErr, strings.Join(e.Keys, ", "))
+}
+
+// Unwrap returns KeyNotFoundErr.
+func (e MissingKeysError) Unwrap() error {
+	return errors.KeyNotFoundErr
+}
+
+// FormatOption changes how Format renders a template.
+type FormatOption func(*formatConfig)
+
+type formatConfig struct {
+	strict  bool
+	escaper Escaper
+}
+
+// StrictFormat makes Format return a MissingKeysError listing every placeholder
+// that has neither a value nor a default.
+// Without it, such placeholders are replaced with an empty string.
+func StrictFormat() FormatOption {
+	return func(c *formatConfig) { c.strict = true }
+}
+
+// EscapeWith makes Format pass each substituted value through escaper.
+func EscapeWith(escaper Escaper) FormatOption {
+	return func(c *formatConfig) { c.escaper = escaper }
+}
+
+// Format replaces the named placeholders in tmpl with values.
+// values may be a map with string keys, a struct, or a pointer to either.
+// Struct fields are found by their name or by a `format:"name"` tag,
+// and nested values can be reached with dotted names such as {user.name}.
+//
+// A placeholder has the form {name}, {name:verb}, {name|default} or {name:verb|default},
+// where verb is a fmt verb such as %.2f (the default is %v) and default is used when
+// the name has no value. Use {{ and }} for literal braces.
+// A BadArgumentErr is returned if tmpl is malformed.
+func Format[TString ~string](tmpl TString, values any, opts ...FormatOption) (TString, error) {
+	config := formatConfig{}
+	list.Iter(func(opt FormatOption) { opt(&config) }, opts)
+
+	parts, err := parseFormat(string(tmpl))
+	if err != nil {
+		return "", fmt.Errorf("%w: Format(%q): %v", errors.BadArgumentErr, tmpl, err)
+	}
+
+	output := &strings.Builder{}
+	missing := []string{}
+	for _, p := range parts {
+		if !p.placeholder {
+			output.WriteString(p.text)
+			continue
+		}
+		var s string
+		if v := lookupFormatValue(values, p.name); v.IsSome() {
+			s = fmt.Sprintf(p.verb, v.Value())
+		} else if p.def.IsSome() {
+			s = p.def.Value()
+		} else {
+			if !list.Contains(p.name, missing...) {
+				missing = append(missing, p.name)
+			}
+			continue
+		}
+		if config.escaper != nil {
+			s = config.escaper(s)
+		}
+		output.WriteString(s)
+	}
+	if config.strict && len(missing) > 0 {
+		return "", MissingKeysError{Keys: missing}
+	}
+	return TString(output.String()), nil
+}
+
+// MustFormat is the same as Format but panics if there is an error.
+func MustFormat[TString ~string](tmpl TString, values any, opts ...FormatOption) TString {
+	s, err := Format(tmpl, values, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+type formatPart struct {
+	text        string
+	placeholder bool
+	name        string
+	verb        string
+	def         option.Option[string]
+}
+
+func parseFormat(tmpl string) ([]formatPart, error) {
+	parts := []formatPart{}
+	text := &strings.Builder{}
+	flush := func() {
+		if text.Len() > 0 {
+			parts = append(parts, formatPart{text: text.String()})
+			text.Reset()
+		}
+	}
+	for i := 0; i < len(tmpl); i++ {
+		c := tmpl[i]
+		switch {
+		case c == '{' && i+1 < len(tmpl) && tmpl[i+1] == '{':
+			text.WriteByte('{')
+			i++
+		case c == '}' && i+1 < len(tmpl) && tmpl[i+1] == '}':
+			text.WriteByte('}')
+			i++
+		case c == '}':
+			return nil, fmt.Errorf("unmatched } at %d", i)
+		case c == '{':
+			end := strings.IndexByte(tmpl[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed placeholder at %d", i)
+			}
+			p, err := parsePlaceholder(tmpl[i+1 : i+end])
+			if err != nil {
+				return nil, fmt.Errorf("placeholder at %d: %v", i, err)
+			}
+			flush()
+			parts = append(parts, p)
+			i += end
+		default:
+			text.WriteByte(c)
+		}
+	}
+	flush()
+	return parts, nil
+}
+
+func parsePlaceholder(body string) (formatPart, error) {
+	p := formatPart{placeholder: true, verb: "%v"}
+	body, def, hasDefault := strings.Cut(body, "|")
+	if hasDefault {
+		p.def = option.Some(def)
+	}
+	name, verb, hasVerb := strings.Cut(body, ":")
+	p.name = strings.TrimSpace(name)
+	if p.name == "" {
+		return p, fmt.Errorf("missing name")
+	}
+	if strings.ContainsRune(p.name, '{') {
+		return p, fmt.Errorf("unexpected { in %q", p.name)
+	}
+	if hasVerb {
+		if !strings.HasPrefix(verb, "%") || len(verb) < 2 {
+			return p, fmt.Errorf("invalid verb %q for %s", verb, p.name)
+		}
+		p.verb = verb
+	}
+	return p, nil
+}
+
+func lookupFormatValue(values any, name string) option.Option[any] {
+	v := reflect.ValueOf(values)
+	for _, segment := range strings.Split(name, ".") {
+		next := lookupFormatSegment(v, segment)
+		if next.IsNone() {
+			return option.None[any]()
+		}
+		v = next.Value()
+	}
+	if !v.IsValid() || !v.CanInterface() {
+		return option.None[any]()
+	}
+	return option.Some(v.Interface())
+}
+
+func lookupFormatSegment(v reflect.Value, name string) option.Option[reflect.Value] {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return option.None[reflect.Value]()
+		}
+		item := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !item.IsValid() {
+			return option.None[reflect.Value]()
+		}
+		return option.Some(item)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if tag, ok := f.Tag.Lookup("format"); ok && tag == name || !ok && f.Name == name {
+				return option.Some(v.Field(i))
+			}
+		}
+	}
+	return option.None[reflect.Value]()
+}
@@ -0,0 +1,87 @@
+package strings
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional/errors"
+)
+
+func ExampleFormat() {
+	s, err := Format("Hello {name}, you owe {amount:%.2f}", map[string]any{"name": "Bob", "amount": 3.5})
+	fmt.Println(s, err)
+	// Output: Hello Bob, you owe 3.50 <nil>
+}
+
+func ExampleFormat_struct() {
+	type account struct {
+		Name    string
+		Balance int `format:"balance"`
+	}
+	s, err := Format("{Name} has {balance} (owner: {owner|anonymous})", account{Name: "savings", Balance: 12})
+	fmt.Println(s, err)
+	// Output: savings has 12 (owner: anonymous) <nil>
+}
+
+func ExampleFormat_strict() {
+	_, err := Format("{greeting} {name}, {name}", map[string]string{}, StrictFormat())
+	fmt.Println(err, errors.Is(err, errors.KeyNotFoundErr))
+	// Output: key not found: greeting, name true
+}
+
+func ExampleFormat_escaping() {
+	s := MustFormat("<p>{comment}</p>", map[string]string{"comment": "<b>hi</b>"}, EscapeWith(HTMLEscaper))
+	fmt.Println(s)
+	// Output: <p>&lt;b&gt;hi&lt;/b&gt;</p>
+}
+
+func ExampleShellEscaper() {
+	s := MustFormat("rm {file}", map[string]string{"file": "it's here"}, EscapeWith(ShellEscaper))
+	fmt.Println(s)
+	// Output: rm 'it'\''s here'
+}
+
+func ExampleSQLLiteralEscaper() {
+	s := MustFormat("SELECT * FROM t WHERE name = {name}", map[string]string{"name": "O'Brien"}, EscapeWith(SQLLiteralEscaper))
+	fmt.Println(s)
+	// Output: SELECT * FROM t WHERE name = 'O''Brien'
+}
+
+func TestFormat(t *testing.T) {
+	type inner struct{ City string }
+	type outer struct {
+		Address *inner
+		hidden  string
+	}
+	cases := []struct {
+		tmpl   string
+		values any
+		want   string
+	}{
+		{"{{literal}}", nil, "{literal}"},
+		{"{a}{b}", map[string]int{"a": 1, "b": 2}, "12"},
+		{"{a:%03d}", map[string]int{"a": 7}, "007"},
+		{"[{missing}]", map[string]int{}, "[]"},
+		{"{missing:%d|none}", map[string]int{}, "none"},
+		{"{Address.City}", outer{Address: &inner{City: "Oslo"}}, "Oslo"},
+		{"{Address.City|?}", outer{}, "?"},
+		{"{hidden|x}", outer{hidden: "secret"}, "x"},
+		{"{user.name}", map[string]any{"user": map[string]string{"name": "ann"}}, "ann"},
+	}
+	for _, tc := range cases {
+		got, err := Format(tc.tmpl, tc.values)
+		if err != nil {
+			t.Errorf("Format(%q) returned error %v", tc.tmpl, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Format(%q) = %q, want %q", tc.tmpl, got, tc.want)
+		}
+	}
+
+	for _, bad := range []string{"{", "}", "{}", "{a:x}", "{a{b}"} {
+		if _, err := Format(bad, nil); !errors.Is(err, errors.BadArgumentErr) {
+			t.Errorf("Format(%q) = %v, want BadArgumentErr", bad, err)
+		}
+	}
+}
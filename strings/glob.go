@@ -0,0 +1,324 @@
+package strings
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+// GlobOption changes how a glob pattern is compiled.
+type GlobOption int
+
+const (
+	// GlobIgnoreCase makes the pattern match without regard to case.
+	GlobIgnoreCase GlobOption = iota + 1
+)
+
+// GlobSeparator is the rune that '*' and '?' will not match.
+// Only "**" matches across it.
+const GlobSeparator = '/'
+
+type globTokenKind int
+
+const (
+	globLiteral globTokenKind = iota
+	globAnyRune
+	globStar
+	globDoubleStar
+	globDirStar
+	globClass
+	globAlternation
+)
+
+type globRange struct {
+	lo, hi rune
+}
+
+type globToken struct {
+	kind    globTokenKind
+	r       rune
+	ranges  []globRange
+	negated bool
+	// alternatives are the token sequences of a {a,b} alternation.
+	alternatives [][]globToken
+}
+
+// Glob is a compiled glob pattern. The zero value matches nothing.
+//
+// The supported syntax is:
+//
+//	?       matches any single rune except GlobSeparator
+//	*       matches any sequence of runes except GlobSeparator
+//	**      matches any sequence of runes, including GlobSeparator
+//	**/     matches zero or more whole path segments
+//	[a-z]   matches a single rune in the class ([!a-z] or [^a-z] negates it)
+//	{a,b}   matches either alternative (alternatives may contain patterns and nest)
+//	\c      matches the rune c literally
+//
+// Alternations are matched as they are reached rather than expanded into every combination,
+// so the time to compile and match a pattern grows with its length, not with the number of alternations.
+type Glob struct {
+	pattern    string
+	ignoreCase bool
+	// tokens is nil only for the zero value.
+	tokens []globToken
+}
+
+// CompileGlob parses pattern into a Glob that can be matched repeatedly.
+// A BadArgumentErr is returned if pattern is malformed.
+func CompileGlob[TString ~string](pattern TString, opts ...GlobOption) (Glob, error) {
+	parser := globParser{pattern: []rune(string(pattern))}
+	tokens, err := parser.parseSequence(false)
+	if err != nil {
+		return Glob{}, fmt.Errorf("%w: CompileGlob(%q): %v", errors.BadArgumentErr, pattern, err)
+	}
+	return Glob{
+		pattern:    string(pattern),
+		ignoreCase: list.Contains(GlobIgnoreCase, opts...),
+		tokens:     tokens,
+	}, nil
+}
+
+// MustCompileGlob is like CompileGlob but panics if pattern is malformed.
+func MustCompileGlob[TString ~string](pattern TString, opts ...GlobOption) Glob {
+	g, err := CompileGlob(pattern, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// String returns the source pattern of the Glob.
+func (g Glob) String() string {
+	return g.pattern
+}
+
+// Match tests whether the whole of s matches the Glob.
+func (g Glob) Match(s string) bool {
+	if g.tokens == nil {
+		return false
+	}
+	input := []rune(s)
+	end := make([]bool, len(input)+1)
+	end[len(input)] = true
+	return g.matchTokens(g.tokens, input, end)[0]
+}
+
+// MatchGlob compiles pattern and tests whether s matches it.
+// If the same pattern is used many times, compile it once with CompileGlob instead.
+func MatchGlob[TString1, TString2 ~string](pattern TString1, s TString2, opts ...GlobOption) (bool, error) {
+	g, err := CompileGlob(pattern, opts...)
+	if err != nil {
+		return false, err
+	}
+	return g.Match(string(s)), nil
+}
+
+// MatchAny tests whether s matches any of the globs.
+func MatchAny[TString ~string](s TString, globs ...Glob) bool {
+	return list.Exists(func(g Glob) bool { return g.Match(string(s)) }, globs...)
+}
+
+// GlobPredicate returns a predicate that tests its input against g.
+// It can be passed directly to functions such as list.Filter.
+func GlobPredicate[TString ~string](g Glob) func(TString) bool {
+	return func(s TString) bool {
+		return g.Match(string(s))
+	}
+}
+
+// MatchAnyPredicate returns a predicate that tests whether its input matches any of the globs.
+// It can be passed directly to functions such as list.Filter.
+func MatchAnyPredicate[TString ~string](globs ...Glob) func(TString) bool {
+	return func(s TString) bool {
+		return MatchAny(s, globs...)
+	}
+}
+
+// matchTokens returns, for each j, whether tokens matches some input[j:k] for which after[k] is true.
+// It works from the last token backwards.
+func (g Glob) matchTokens(tokens []globToken, input []rune, after []bool) []bool {
+	// matches[j] reports whether tokens[i:] followed by after matches from input[j].
+	matches := after
+	for i := len(tokens) - 1; i >= 0; i-- {
+		t := tokens[i]
+		next := make([]bool, len(input)+1)
+		if t.kind == globAlternation {
+			for _, alt := range t.alternatives {
+				for j, ok := range g.matchTokens(alt, input, matches) {
+					next[j] = next[j] || ok
+				}
+			}
+			matches = next
+			continue
+		}
+		// endsInSeparator reports whether some input[j:k] ending in a separator is followed by a match.
+		endsInSeparator := false
+		for j := len(input); j >= 0; j-- {
+			more := j < len(input)
+			switch t.kind {
+			case globStar:
+				next[j] = matches[j] || (more && input[j] != GlobSeparator && next[j+1])
+			case globDoubleStar:
+				next[j] = matches[j] || (more && next[j+1])
+			case globDirStar:
+				endsInSeparator = more && (endsInSeparator || (input[j] == GlobSeparator && matches[j+1]))
+				next[j] = matches[j] || endsInSeparator
+			default:
+				next[j] = more && g.matchRune(t, input[j]) && matches[j+1]
+			}
+		}
+		matches = next
+	}
+	return matches
+}
+
+func (g Glob) matchRune(t globToken, r rune) bool {
+	switch t.kind {
+	case globAnyRune:
+		return r != GlobSeparator
+	case globLiteral:
+		if g.ignoreCase {
+			return unicode.ToLower(t.r) == unicode.ToLower(r)
+		}
+		return t.r == r
+	case globClass:
+		in := classContains(t.ranges, r)
+		if g.ignoreCase && !in {
+			in = classContains(t.ranges, unicode.ToLower(r)) || classContains(t.ranges, unicode.ToUpper(r))
+		}
+		return in != t.negated && r != GlobSeparator
+	}
+	return false
+}
+
+func classContains(ranges []globRange, r rune) bool {
+	return list.Exists(func(rg globRange) bool { return rg.lo <= r && r <= rg.hi }, ranges...)
+}
+
+type globParser struct {
+	pattern []rune
+	i       int
+}
+
+// parseSequence parses tokens up to the end of the pattern or, inside an alternation,
+// up to the ',' or '}' that ends the alternative, which is left for parseAlternation.
+func (p *globParser) parseSequence(inAlternation bool) ([]globToken, error) {
+	tokens := []globToken{}
+	for ; p.i < len(p.pattern); p.i++ {
+		switch r := p.pattern[p.i]; r {
+		case '\\':
+			if p.i+1 >= len(p.pattern) {
+				return nil, fmt.Errorf("trailing escape")
+			}
+			p.i++
+			tokens = append(tokens, globToken{kind: globLiteral, r: p.pattern[p.i]})
+		case '?':
+			tokens = append(tokens, globToken{kind: globAnyRune})
+		case '*':
+			if p.i+1 < len(p.pattern) && p.pattern[p.i+1] == '*' {
+				p.i++
+				for p.i+1 < len(p.pattern) && p.pattern[p.i+1] == '*' {
+					p.i++
+				}
+				if p.i+1 < len(p.pattern) && p.pattern[p.i+1] == GlobSeparator {
+					p.i++
+					tokens = append(tokens, globToken{kind: globDirStar})
+				} else {
+					tokens = append(tokens, globToken{kind: globDoubleStar})
+				}
+				continue
+			}
+			tokens = append(tokens, globToken{kind: globStar})
+		case '[':
+			t, end, err := parseClass(p.pattern, p.i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+			p.i = end
+		case '{':
+			t, err := p.parseAlternation()
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+		case ',', '}':
+			if inAlternation {
+				return tokens, nil
+			}
+			if r == '}' {
+				return nil, fmt.Errorf("unmatched } at %d", p.i)
+			}
+			tokens = append(tokens, globToken{kind: globLiteral, r: r})
+		default:
+			tokens = append(tokens, globToken{kind: globLiteral, r: r})
+		}
+	}
+	return tokens, nil
+}
+
+// parseAlternation parses the alternation starting at the '{' at p.i and leaves p.i at its closing '}'.
+func (p *globParser) parseAlternation() (globToken, error) {
+	open := p.i
+	t := globToken{kind: globAlternation}
+	for {
+		p.i++
+		alt, err := p.parseSequence(true)
+		if err != nil {
+			return t, err
+		}
+		t.alternatives = append(t.alternatives, alt)
+		if p.i >= len(p.pattern) {
+			return t, fmt.Errorf("unclosed { at %d", open)
+		}
+		if p.pattern[p.i] == '}' {
+			return t, nil
+		}
+	}
+}
+
+// parseClass parses the character class starting at pattern[start] == '['
+// and returns the index of the closing ']'.
+func parseClass(pattern []rune, start int) (globToken, int, error) {
+	t := globToken{kind: globClass}
+	i := start + 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		t.negated = true
+		i++
+	}
+	first := true
+	for ; i < len(pattern); i++ {
+		r := pattern[i]
+		if r == ']' && !first {
+			return t, i, nil
+		}
+		first = false
+		if r == '\\' {
+			if i+1 >= len(pattern) {
+				return t, 0, fmt.Errorf("trailing escape")
+			}
+			i++
+			r = pattern[i]
+		}
+		lo, hi := r, r
+		if i+2 < len(pattern) && pattern[i+1] == '-' && pattern[i+2] != ']' {
+			hi = pattern[i+2]
+			i += 2
+			if hi == '\\' {
+				if i+1 >= len(pattern) {
+					return t, 0, fmt.Errorf("trailing escape")
+				}
+				i++
+				hi = pattern[i]
+			}
+			if hi < lo {
+				return t, 0, fmt.Errorf("invalid range %c-%c", lo, hi)
+			}
+		}
+		t.ranges = append(t.ranges, globRange{lo: lo, hi: hi})
+	}
+	return t, 0, fmt.Errorf("unclosed character class at %d", start)
+}
@@ -0,0 +1,125 @@
+package strings
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/flowonyx/functional/list"
+)
+
+func ExampleCompileGlob() {
+	g, err := CompileGlob("user-??")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(g.Match("user-01"), g.Match("user-1"), g.Match("admin-01"))
+	// Output: true false false
+}
+
+func ExampleCompileGlob_ignoreCase() {
+	g := MustCompileGlob("*.GO", GlobIgnoreCase)
+	fmt.Println(g.Match("main.go"), g.Match("main.Go"), g.Match("main.rs"))
+	// Output: true true false
+}
+
+func ExampleGlob_Match() {
+	g := MustCompileGlob("src/**/*.{go,mod}")
+	fmt.Println(g.Match("src/main.go"), g.Match("src/pkg/list/map.go"), g.Match("src/go.mod"), g.Match("src/pkg/README.md"))
+	// Output: true true true false
+}
+
+func ExampleMatchGlob() {
+	ok, err := MatchGlob("[a-c]*", "banana")
+	fmt.Println(ok, err)
+	// Output: true <nil>
+}
+
+func ExampleMatchAny() {
+	globs := []Glob{MustCompileGlob("*.go"), MustCompileGlob("*.md")}
+	fmt.Println(MatchAny("README.md", globs...), MatchAny("go.sum", globs...))
+	// Output: true false
+}
+
+func ExampleGlobPredicate() {
+	files := []string{"main.go", "main_test.go", "README.md", "go.mod"}
+	r := list.Filter(GlobPredicate[string](MustCompileGlob("*_test.go")), files...)
+	fmt.Println(r)
+	// Output: [main_test.go]
+}
+
+func ExampleMatchAnyPredicate() {
+	files := []string{"main.go", "main_test.go", "README.md", "go.mod"}
+	r := list.Filter(MatchAnyPredicate[string](MustCompileGlob("*.md"), MustCompileGlob("go.*")), files...)
+	fmt.Println(r)
+	// Output: [README.md go.mod]
+}
+
+func TestGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		input   string
+		want    bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "abc", true},
+		{"*", "a/b", false},
+		{"**", "a/b", true},
+		{"a/*/c", "a/b/c", true},
+		{"a/*/c", "a/b/d/c", false},
+		{"a/**/c", "a/c", true},
+		{"a/**/c", "a/b/c", true},
+		{"a/**/c", "a/b/d/c", true},
+		{"a/**/c", "a/bc", false},
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "a/b/main.go", true},
+		{"a/**", "a/b/c", true},
+		{"?", "é", true},
+		{"?", "/", false},
+		{"[!a-c]", "d", true},
+		{"[^a-c]", "b", false},
+		{"[]]", "]", true},
+		{"[a-]", "-", true},
+		{`\*`, "*", true},
+		{`\*`, "a", false},
+		{"{a,b}{c,d}", "bd", true},
+		{"{a,{b,c}x}", "cx", true},
+		{"{a,{b,c}x}", "c", false},
+		{"{,x}y", "y", true},
+		{"[{,}]", ",", true},
+		{"*a*b*", "xxaxxbxx", true},
+		{"*a*b*", "xxbxxaxx", false},
+	}
+	for _, tc := range cases {
+		g, err := CompileGlob(tc.pattern)
+		if err != nil {
+			t.Errorf("CompileGlob(%q) returned error %v", tc.pattern, err)
+			continue
+		}
+		if got := g.Match(tc.input); got != tc.want {
+			t.Errorf("CompileGlob(%q).Match(%q) = %t, want %t", tc.pattern, tc.input, got, tc.want)
+		}
+	}
+
+	for _, bad := range []string{"[a", "{a,b", "a}", `a\`, "[z-a]"} {
+		if _, err := CompileGlob(bad); err == nil {
+			t.Errorf("CompileGlob(%q) expected an error", bad)
+		}
+	}
+}
+
+// TestGlobManyAlternations checks that alternations are not expanded into every combination,
+// which would take 2^40 patterns here.
+func TestGlobManyAlternations(t *testing.T) {
+	pattern := strings.Repeat("{a,b}", 40) + "{x,y/**}"
+	g, err := CompileGlob(pattern)
+	if err != nil {
+		t.Fatalf("CompileGlob returned error %v", err)
+	}
+	input := strings.Repeat("ab", 20)
+	if !g.Match(input+"x") || !g.Match(input+"y/z/w") || g.Match(input+"c") || g.Match(input) {
+		t.Errorf("CompileGlob(%q) matched wrongly", pattern)
+	}
+}
@@ -8,11 +8,14 @@ import (
 
 // IsDate checks if the given string is a date according to either the given format(s)
 // or the default formats if none are provided.
+// Use the validate package when you need to know why a string is not a date.
 func IsDate[TString ~string](s TString, formats ...string) bool {
 	return findDateFormat(s, formats...).IsSome()
 }
 
 // IsEmail checks if the given string can be parsed as an email address.
+// This accepts display names such as "Bob <bob@example.com>".
+// Use the validate package for stricter checking and to know why a string is not an email address.
 func IsEmail[TString ~string](s TString) bool {
 	if _, err := mail.ParseAddress(string(s)); err != nil {
 		return false
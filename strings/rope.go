@@ -0,0 +1,378 @@
+package strings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
+// maxRopeLeaf is the largest number of runes kept in a single leaf of a Rope.
+const maxRopeLeaf = 512
+
+// Rope is a persistent string type for efficient editing of large texts.
+// Edits return a new Rope and leave the original unchanged, sharing most of their structure.
+// Insert, Delete, Slice, RuneAt and the line lookups are O(log n) in the number of runes.
+// The zero value is an empty Rope.
+type Rope struct {
+	root *ropeNode
+}
+
+type ropeNode struct {
+	left, right *ropeNode
+	leaf        []rune
+	runes       int
+	newlines    int
+	height      int
+}
+
+// NewRope creates a Rope containing s.
+func NewRope[TString ~string](s TString) Rope {
+	runes := []rune(string(s))
+	leaves := make([]*ropeNode, 0, len(runes)/maxRopeLeaf+1)
+	for start := 0; start < len(runes); start += maxRopeLeaf {
+		end := start + maxRopeLeaf
+		if end > len(runes) {
+			end = len(runes)
+		}
+		leaves = append(leaves, newRopeLeaf(runes[start:end:end]))
+	}
+	return Rope{root: buildRope(leaves)}
+}
+
+func buildRope(leaves []*ropeNode) *ropeNode {
+	switch len(leaves) {
+	case 0:
+		return nil
+	case 1:
+		return leaves[0]
+	}
+	mid := len(leaves) / 2
+	return newRopeBranch(buildRope(leaves[:mid]), buildRope(leaves[mid:]))
+}
+
+func newRopeLeaf(runes []rune) *ropeNode {
+	n := &ropeNode{leaf: runes, runes: len(runes)}
+	for _, r := range runes {
+		if r == '\n' {
+			n.newlines++
+		}
+	}
+	return n
+}
+
+func newRopeBranch(left, right *ropeNode) *ropeNode {
+	return &ropeNode{
+		left:     left,
+		right:    right,
+		runes:    left.runes + right.runes,
+		newlines: left.newlines + right.newlines,
+		height:   max(left.height, right.height) + 1,
+	}
+}
+
+func (n *ropeNode) isLeaf() bool {
+	return n.left == nil
+}
+
+func ropeRotateLeft(n *ropeNode) *ropeNode {
+	return newRopeBranch(newRopeBranch(n.left, n.right.left), n.right.right)
+}
+
+func ropeRotateRight(n *ropeNode) *ropeNode {
+	return newRopeBranch(n.left.left, newRopeBranch(n.left.right, n.right))
+}
+
+// joinRopes concatenates two trees, rebalancing so heights stay logarithmic.
+func joinRopes(left, right *ropeNode) *ropeNode {
+	if left == nil || left.runes == 0 {
+		return right
+	}
+	if right == nil || right.runes == 0 {
+		return left
+	}
+	if left.isLeaf() && right.isLeaf() && left.runes+right.runes <= maxRopeLeaf {
+		merged := make([]rune, 0, left.runes+right.runes)
+		merged = append(append(merged, left.leaf...), right.leaf...)
+		return newRopeLeaf(merged)
+	}
+	switch {
+	case left.height > right.height+1:
+		return ropeJoinRight(left, right)
+	case right.height > left.height+1:
+		return ropeJoinLeft(left, right)
+	}
+	return newRopeBranch(left, right)
+}
+
+func ropeJoinRight(left, right *ropeNode) *ropeNode {
+	l, c := left.left, left.right
+	if c.height <= right.height+1 {
+		t := joinRopes(c, right)
+		if t.height <= l.height+1 {
+			return newRopeBranch(l, t)
+		}
+		return ropeRotateLeft(newRopeBranch(l, ropeRotateRight(t)))
+	}
+	t := ropeJoinRight(c, right)
+	if t.height <= l.height+1 {
+		return newRopeBranch(l, t)
+	}
+	return ropeRotateLeft(newRopeBranch(l, t))
+}
+
+func ropeJoinLeft(left, right *ropeNode) *ropeNode {
+	c, r := right.left, right.right
+	if c.height <= left.height+1 {
+		t := joinRopes(left, c)
+		if t.height <= r.height+1 {
+			return newRopeBranch(t, r)
+		}
+		return ropeRotateRight(newRopeBranch(ropeRotateLeft(t), r))
+	}
+	t := ropeJoinLeft(left, c)
+	if t.height <= r.height+1 {
+		return newRopeBranch(t, r)
+	}
+	return ropeRotateRight(newRopeBranch(t, r))
+}
+
+// splitRope divides the tree so the first part holds the first index runes.
+func splitRope(n *ropeNode, index int) (*ropeNode, *ropeNode) {
+	if n == nil {
+		return nil, nil
+	}
+	if n.isLeaf() {
+		if index <= 0 {
+			return nil, n
+		}
+		if index >= n.runes {
+			return n, nil
+		}
+		return newRopeLeaf(n.leaf[:index:index]), newRopeLeaf(n.leaf[index:])
+	}
+	switch {
+	case index < n.left.runes:
+		ll, lr := splitRope(n.left, index)
+		return ll, joinRopes(lr, n.right)
+	case index > n.left.runes:
+		rl, rr := splitRope(n.right, index-n.left.runes)
+		return joinRopes(n.left, rl), rr
+	}
+	return n.left, n.right
+}
+
+// Len returns the number of runes in the Rope.
+func (r Rope) Len() int {
+	if r.root == nil {
+		return 0
+	}
+	return r.root.runes
+}
+
+// IsEmpty tests whether the Rope contains no runes.
+func (r Rope) IsEmpty() bool {
+	return r.Len() == 0
+}
+
+// String returns the contents of the Rope as a string.
+func (r Rope) String() string {
+	output := &strings.Builder{}
+	output.Grow(r.Len())
+	r.IterChunks(func(chunk string) { output.WriteString(chunk) })
+	return output.String()
+}
+
+// IterChunks applies action to each chunk of the Rope in order.
+// Concatenating the chunks gives the contents of the Rope.
+func (r Rope) IterChunks(action func(string)) {
+	var walk func(*ropeNode)
+	walk = func(n *ropeNode) {
+		if n == nil {
+			return
+		}
+		if n.isLeaf() {
+			action(string(n.leaf))
+			return
+		}
+		walk(n.left)
+		walk(n.right)
+	}
+	walk(r.root)
+}
+
+// Chunks returns the chunks of the Rope in order.
+func (r Rope) Chunks() []string {
+	output := []string{}
+	r.IterChunks(func(chunk string) { output = append(output, chunk) })
+	return output
+}
+
+// Concat returns a Rope containing r followed by other.
+func (r Rope) Concat(other Rope) Rope {
+	return Rope{root: joinRopes(r.root, other.root)}
+}
+
+// Append returns a Rope with s added to the end of r.
+func (r Rope) Append(s string) Rope {
+	return r.Concat(NewRope(s))
+}
+
+// Insert returns a Rope with s inserted before the rune at index.
+// If index is not in the range 0 to r.Len(), it returns an IndexOutOfRangeErr.
+func (r Rope) Insert(index int, s string) (Rope, error) {
+	if index < 0 || index > r.Len() {
+		return r, fmt.Errorf("%w: Rope.Insert(%d, _) with length %d", errors.IndexOutOfRangeErr, index, r.Len())
+	}
+	left, right := splitRope(r.root, index)
+	return Rope{root: joinRopes(joinRopes(left, NewRope(s).root), right)}, nil
+}
+
+// Delete returns a Rope with count runes removed starting at index.
+// If count is larger than the number of runes after index, it only removes as many as there are.
+// If index is not in the range of indexes for r, it returns an IndexOutOfRangeErr.
+func (r Rope) Delete(index, count int) (Rope, error) {
+	if index < 0 || index >= r.Len() {
+		return r, fmt.Errorf("%w: Rope.Delete(%d, %d) with length %d", errors.IndexOutOfRangeErr, index, count, r.Len())
+	}
+	if count <= 0 {
+		return r, nil
+	}
+	left, rest := splitRope(r.root, index)
+	_, right := splitRope(rest, count)
+	return Rope{root: joinRopes(left, right)}, nil
+}
+
+// Slice returns a Rope of the runes from start up to but not including end.
+// If start and end do not describe a valid range within r, it returns an IndexOutOfRangeErr.
+func (r Rope) Slice(start, end int) (Rope, error) {
+	if start < 0 || end > r.Len() || start > end {
+		return Rope{}, fmt.Errorf("%w: Rope.Slice(%d, %d) with length %d", errors.IndexOutOfRangeErr, start, end, r.Len())
+	}
+	_, rest := splitRope(r.root, start)
+	middle, _ := splitRope(rest, end-start)
+	return Rope{root: middle}, nil
+}
+
+// RuneAt returns the rune at index.
+// If index is not in the range of indexes for r, it returns an IndexOutOfRangeErr.
+func (r Rope) RuneAt(index int) (rune, error) {
+	if index < 0 || index >= r.Len() {
+		return 0, fmt.Errorf("%w: Rope.RuneAt(%d) with length %d", errors.IndexOutOfRangeErr, index, r.Len())
+	}
+	n := r.root
+	for !n.isLeaf() {
+		if index < n.left.runes {
+			n = n.left
+		} else {
+			index -= n.left.runes
+			n = n.right
+		}
+	}
+	return n.leaf[index], nil
+}
+
+// TryRuneAt returns the rune at index as an Option.
+// If index is not in the range of indexes for r, it returns None.
+func (r Rope) TryRuneAt(index int) option.Option[rune] {
+	if c, err := r.RuneAt(index); err != nil {
+		return option.None[rune]()
+	} else {
+		return option.Some(c)
+	}
+}
+
+// LineCount returns the number of lines in the Rope.
+// Lines are separated by '\n', so an empty Rope has one line.
+func (r Rope) LineCount() int {
+	if r.root == nil {
+		return 1
+	}
+	return r.root.newlines + 1
+}
+
+// LineStart returns the rune index at which the line indicated by line begins.
+// If line is out of range, it returns a BadArgumentErr like GetLine.
+func (r Rope) LineStart(line int) (int, error) {
+	if line < 0 || line >= r.LineCount() {
+		return 0, fmt.Errorf("%w: requested line out of range: %d", errors.BadArgumentErr, line)
+	}
+	if line == 0 {
+		return 0, nil
+	}
+	// find the rune index of the line-th newline
+	n, offset, remaining := r.root, 0, line
+	for !n.isLeaf() {
+		if remaining <= n.left.newlines {
+			n = n.left
+		} else {
+			remaining -= n.left.newlines
+			offset += n.left.runes
+			n = n.right
+		}
+	}
+	for i, c := range n.leaf {
+		if c == '\n' {
+			remaining--
+			if remaining == 0 {
+				return offset + i + 1, nil
+			}
+		}
+	}
+	panic("Rope newline counts are inconsistent")
+}
+
+// LineColumn returns the zero-based line and column of the rune at index.
+// An index equal to r.Len() is allowed and gives the position just after the last rune.
+// If index is out of range, it returns an IndexOutOfRangeErr.
+func (r Rope) LineColumn(index int) (line, column int, err error) {
+	if index < 0 || index > r.Len() {
+		return 0, 0, fmt.Errorf("%w: Rope.LineColumn(%d) with length %d", errors.IndexOutOfRangeErr, index, r.Len())
+	}
+	n, remaining := r.root, index
+	for n != nil && !n.isLeaf() {
+		if remaining < n.left.runes {
+			n = n.left
+		} else {
+			line += n.left.newlines
+			remaining -= n.left.runes
+			n = n.right
+		}
+	}
+	if n != nil {
+		for _, c := range n.leaf[:remaining] {
+			if c == '\n' {
+				line++
+			}
+		}
+	}
+	start, err := r.LineStart(line)
+	if err != nil {
+		return 0, 0, err
+	}
+	return line, index - start, nil
+}
+
+// GetLine gets the value of the line indicated by index, without its newline.
+// A trailing '\r' is also removed so "\r\n" line endings are handled.
+// An error is returned if the index is out of range, like the GetLine function.
+func (r Rope) GetLine(index int) (string, error) {
+	start, err := r.LineStart(index)
+	if err != nil {
+		return "", err
+	}
+	end := r.Len()
+	if index+1 < r.LineCount() {
+		next, err := r.LineStart(index + 1)
+		if err != nil {
+			return "", err
+		}
+		end = next - 1
+	}
+	line, err := r.Slice(start, end)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(line.String(), "\r"), nil
+}
@@ -0,0 +1,167 @@
+package strings
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func ExampleNewRope() {
+	r := NewRope("hello world")
+	fmt.Println(r.Len(), r.String())
+	// Output: 11 hello world
+}
+
+func ExampleRope_Insert() {
+	r := NewRope("hello world")
+	r2, err := r.Insert(5, ",")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(r2, "|", r)
+	// Output: hello, world | hello world
+}
+
+func ExampleRope_Delete() {
+	r, err := NewRope("hello cruel world").Delete(6, 6)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(r)
+	// Output: hello world
+}
+
+func ExampleRope_Slice() {
+	r, err := NewRope("héllo world").Slice(1, 5)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(r)
+	// Output: éllo
+}
+
+func ExampleRope_RuneAt() {
+	r := NewRope("héllo")
+	c, _ := r.RuneAt(1)
+	fmt.Println(string(c), r.TryRuneAt(9))
+	// Output: é None
+}
+
+func ExampleRope_LineColumn() {
+	r := NewRope("one\ntwo\nthree")
+	line, column, _ := r.LineColumn(9)
+	fmt.Println(r.LineCount(), line, column)
+	// Output: 3 2 1
+}
+
+func ExampleRope_GetLine() {
+	r := NewRope("one\r\ntwo\nthree")
+	l, _ := r.GetLine(0)
+	l2, _ := r.GetLine(2)
+	_, err := r.GetLine(3)
+	fmt.Printf("%q %q %v", l, l2, err)
+	// Output: "one" "three" bad argument: requested line out of range: 3
+}
+
+func ExampleRope_Chunks() {
+	r := NewRope("hello").Append(" ").Append("world")
+	fmt.Println(len(r.Chunks()), r.Chunks()[0])
+	// Output: 1 hello world
+}
+
+func TestRopeEdits(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	alphabet := []rune("abcé\n")
+	randomString := func(n int) string {
+		rs := make([]rune, n)
+		for i := range rs {
+			rs[i] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		return string(rs)
+	}
+
+	want := []rune(randomString(3000))
+	r := NewRope(string(want))
+	for step := 0; step < 2000; step++ {
+		switch rnd.Intn(3) {
+		case 0:
+			i := rnd.Intn(len(want) + 1)
+			s := randomString(rnd.Intn(700))
+			var err error
+			if r, err = r.Insert(i, s); err != nil {
+				t.Fatal(err)
+			}
+			want = append(want[:i:i], append([]rune(s), want[i:]...)...)
+		case 1:
+			if len(want) == 0 {
+				continue
+			}
+			i := rnd.Intn(len(want))
+			count := rnd.Intn(700)
+			var err error
+			if r, err = r.Delete(i, count); err != nil {
+				t.Fatal(err)
+			}
+			end := min(i+count, len(want))
+			want = append(want[:i:i], want[end:]...)
+		case 2:
+			start := rnd.Intn(len(want) + 1)
+			end := start + rnd.Intn(len(want)-start+1)
+			s, err := r.Slice(start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.String() != string(want[start:end]) {
+				t.Fatalf("Slice(%d, %d) = %q, want %q", start, end, s.String(), string(want[start:end]))
+			}
+		}
+		if r.Len() != len(want) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, r.Len(), len(want))
+		}
+		if r.root != nil && r.root.height > 40 {
+			t.Fatalf("step %d: rope height %d is not balanced", step, r.root.height)
+		}
+	}
+	if r.String() != string(want) {
+		t.Fatalf("final rope does not match expected string")
+	}
+
+	lines := Lines(string(want))
+	if r.LineCount() != len(lines) {
+		t.Fatalf("LineCount() = %d, want %d", r.LineCount(), len(lines))
+	}
+	for i, l := range lines {
+		got, err := r.GetLine(i)
+		if err != nil || got != l {
+			t.Fatalf("GetLine(%d) = %q, %v, want %q", i, got, err, l)
+		}
+	}
+	line, column := 0, 0
+	for i, c := range want {
+		gl, gc, err := r.LineColumn(i)
+		if err != nil || gl != line || gc != column {
+			t.Fatalf("LineColumn(%d) = %d, %d, %v, want %d, %d", i, gl, gc, err, line, column)
+		}
+		if c == '\n' {
+			line, column = line+1, 0
+		} else {
+			column++
+		}
+	}
+}
+
+func TestRopeOutOfRange(t *testing.T) {
+	r := NewRope("abc")
+	if _, err := r.Insert(4, "x"); err == nil {
+		t.Error("Insert past the end should fail")
+	}
+	if _, err := r.Delete(3, 1); err == nil {
+		t.Error("Delete past the end should fail")
+	}
+	if _, err := r.Slice(2, 1); err == nil {
+		t.Error("Slice with start after end should fail")
+	}
+	if _, _, err := (Rope{}).LineColumn(0); err != nil {
+		t.Errorf("LineColumn(0) of empty rope returned %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package tree
+
+import (
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+)
+
+// CycleErr is returned by FromParentLinks when following the parents of some rows never reaches a root.
+// The ids of those rows can be found with errors.FieldOf[[]ID](err, "ids").
+const CycleErr = errors.FunctionalError("parent links form a cycle")
+
+// FromParentLinks builds trees from rows that each name their own id and the id of their parent,
+// which is how trees are usually stored in a database table.
+// parent returns None for rows at the root of a tree. Children keep the order of the rows,
+// and the trees are in the order of their roots.
+// It returns a BadArgumentErr if two rows have the same id, a KeyNotFoundErr if a parent id
+// does not belong to any row, or a CycleErr if some rows cannot be reached from a root.
+func FromParentLinks[T any, ID comparable](id func(T) ID, parent func(T) option.Option[ID], rows []T) ([]Tree[T], error) {
+	index := make(map[ID]int, len(rows))
+	for i, r := range rows {
+		if _, ok := index[id(r)]; ok {
+			return nil, errors.BadArgument("FromParentLinks", "rows", "duplicate id").With("id", id(r))
+		}
+		index[id(r)] = i
+	}
+
+	var roots []int
+	children := make(map[int][]int, len(rows))
+	for i, r := range rows {
+		p := parent(r)
+		if p.IsNone() {
+			roots = append(roots, i)
+			continue
+		}
+		pi, ok := index[p.Value()]
+		if !ok {
+			return nil, errors.KeyNotFound("FromParentLinks", p.Value()).With("id", id(r))
+		}
+		children[pi] = append(children[pi], i)
+	}
+
+	built := 0
+	var build func(i int) Tree[T]
+	build = func(i int) Tree[T] {
+		built++
+		t := New(rows[i])
+		for _, c := range children[i] {
+			t.Children = append(t.Children, build(c))
+		}
+		return t
+	}
+	trees := make([]Tree[T], len(roots))
+	for i, r := range roots {
+		trees[i] = build(r)
+	}
+
+	if built < len(rows) {
+		reached := make(map[ID]bool, built)
+		for _, v := range Flatten(trees...) {
+			reached[id(v)] = true
+		}
+		var ids []ID
+		for _, r := range rows {
+			if !reached[id(r)] {
+				ids = append(ids, id(r))
+			}
+		}
+		return nil, errors.Wrap(CycleErr, "FromParentLinks").With("ids", ids)
+	}
+	return trees, nil
+}
+
+// ToParentLinks applies toRow to each value of the trees in pre-order along with the value of its parent,
+// which is None for the roots. It is the reverse of FromParentLinks.
+func ToParentLinks[T, R any](toRow func(value T, parent option.Option[T]) R, trees ...Tree[T]) []R {
+	var output []R
+	var visit func(t Tree[T], parent option.Option[T])
+	visit = func(t Tree[T], parent option.Option[T]) {
+		output = append(output, toRow(t.Value, parent))
+		for _, c := range t.Children {
+			visit(c, option.Some(t.Value))
+		}
+	}
+	for _, t := range trees {
+		visit(t, option.None[T]())
+	}
+	return output
+}
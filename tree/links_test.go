@@ -0,0 +1,75 @@
+package tree_test
+
+import (
+	"fmt"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/option"
+	"github.com/flowonyx/functional/tree"
+)
+
+type category struct {
+	ID       int
+	ParentID int
+	Name     string
+}
+
+func categoryID(c category) int { return c.ID }
+
+func categoryParent(c category) option.Option[int] {
+	if c.ParentID == 0 {
+		return option.None[int]()
+	}
+	return option.Some(c.ParentID)
+}
+
+func ExampleFromParentLinks() {
+	rows := []category{
+		{ID: 3, ParentID: 1, Name: "laptops"},
+		{ID: 1, Name: "computers"},
+		{ID: 4, ParentID: 2, Name: "phones"},
+		{ID: 2, Name: "mobile"},
+		{ID: 5, ParentID: 1, Name: "desktops"},
+	}
+	trees, err := tree.FromParentLinks(categoryID, categoryParent, rows)
+	fmt.Println(err)
+	for _, t := range trees {
+		fmt.Print(tree.Sprint(func(c category) string { return c.Name }, t))
+	}
+	// Output:
+	// <nil>
+	// computers
+	// ├── laptops
+	// └── desktops
+	// mobile
+	// └── phones
+}
+
+func ExampleFromParentLinks_errors() {
+	_, err := tree.FromParentLinks(categoryID, categoryParent, []category{{ID: 1}, {ID: 2, ParentID: 9}})
+	fmt.Println(err)
+
+	_, err = tree.FromParentLinks(categoryID, categoryParent, []category{{ID: 1}, {ID: 1}})
+	fmt.Println(err)
+
+	_, err = tree.FromParentLinks(categoryID, categoryParent, []category{{ID: 1}, {ID: 2, ParentID: 3}, {ID: 3, ParentID: 2}})
+	fmt.Println(errors.Is(err, tree.CycleErr))
+	fmt.Println(errors.FieldOf[[]int](err, "ids"))
+	// Output:
+	// key not found: FromParentLinks(key=9, id=2)
+	// bad argument: FromParentLinks(arg=rows, id=1): duplicate id
+	// true
+	// [2 3] true
+}
+
+func ExampleToParentLinks() {
+	t := tree.New("a", tree.New("b", tree.New("c")), tree.New("d"))
+	rows := tree.ToParentLinks(func(v string, parent option.Option[string]) string {
+		return fmt.Sprintf("%s<-%s", v, option.DefaultValue("", parent))
+	}, t)
+	fmt.Println(rows)
+	fmt.Println(tree.Flatten(t, tree.New("e")))
+	// Output:
+	// [a<- b<-a c<-b d<-a]
+	// [a b c d e]
+}
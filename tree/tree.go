@@ -0,0 +1,210 @@
+// Package tree provides a generic tree where each node has a value and any number of children,
+// along with functions for transforming, searching and printing it and a Zipper for navigating and editing it.
+package tree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flowonyx/functional/collections"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/option"
+)
+
+// Tree is a node with a value and any number of children, each of which is also a Tree.
+// The functions in this package never change a Tree they are given; they return new ones.
+type Tree[T any] struct {
+	Value    T
+	Children []Tree[T]
+}
+
+// New creates a Tree with value at its root and the given children.
+func New[T any](value T, children ...Tree[T]) Tree[T] {
+	return Tree[T]{Value: value, Children: children}
+}
+
+// Leaves creates a Tree for each value with no children.
+func Leaves[T any](values ...T) []Tree[T] {
+	return list.Map(func(v T) Tree[T] { return New(v) }, values)
+}
+
+// IsLeaf tests whether the Tree has no children.
+func (t Tree[T]) IsLeaf() bool {
+	return len(t.Children) == 0
+}
+
+// Size returns the number of nodes in the Tree.
+func (t Tree[T]) Size() int {
+	return FoldUp(func(_ T, children []int) int { return 1 + list.Sum(children) }, t)
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf.
+func (t Tree[T]) Height() int {
+	tallest := func(a, b int) int { return max(a, b) }
+	return FoldUp(func(_ T, children []int) int { return 1 + list.Fold(tallest, 0, children) }, t)
+}
+
+// String prints the Tree with one value on each line and lines showing how they are connected.
+func (t Tree[T]) String() string {
+	return Sprint(func(v T) string { return fmt.Sprint(v) }, t)
+}
+
+// Sprint prints the Tree with the label of one value on each line and lines showing how they are connected.
+func Sprint[T any](label func(T) string, t Tree[T]) string {
+	var b strings.Builder
+	var write func(t Tree[T], prefix string)
+	write = func(t Tree[T], prefix string) {
+		for i, c := range t.Children {
+			branch, indent := "├── ", "│   "
+			if i == len(t.Children)-1 {
+				branch, indent = "└── ", "    "
+			}
+			fmt.Fprintf(&b, "%s%s%s\n", prefix, branch, label(c.Value))
+			write(c, prefix+indent)
+		}
+	}
+	b.WriteString(label(t.Value) + "\n")
+	write(t, "")
+	return b.String()
+}
+
+// Map returns a Tree of the same shape with mapping applied to each value.
+func Map[T, R any](mapping func(T) R, t Tree[T]) Tree[R] {
+	return Tree[R]{
+		Value:    mapping(t.Value),
+		Children: list.Map(func(c Tree[T]) Tree[R] { return Map(mapping, c) }, t.Children),
+	}
+}
+
+// MapWithDepth returns a Tree of the same shape with mapping applied to each value and its depth,
+// which is 0 for the root.
+func MapWithDepth[T, R any](mapping func(depth int, value T) R, t Tree[T]) Tree[R] {
+	var mapAt func(depth int, t Tree[T]) Tree[R]
+	mapAt = func(depth int, t Tree[T]) Tree[R] {
+		return Tree[R]{
+			Value:    mapping(depth, t.Value),
+			Children: list.Map(func(c Tree[T]) Tree[R] { return mapAt(depth+1, c) }, t.Children),
+		}
+	}
+	return mapAt(0, t)
+}
+
+// Fold applies folder to each value in pre-order, threading an accumulator through the computation.
+func Fold[State, T any](folder func(State, T) State, initialState State, t Tree[T]) State {
+	return list.Fold(folder, initialState, PreOrder(t))
+}
+
+// FoldUp computes a result for each node from its value and the results of its children,
+// starting at the leaves and ending with the result for the root.
+func FoldUp[T, R any](folder func(value T, children []R) R, t Tree[T]) R {
+	return folder(t.Value, list.Map(func(c Tree[T]) R { return FoldUp(folder, c) }, t.Children))
+}
+
+// Filter returns a Tree without the nodes whose values do not match predicate, pruning their children along with them.
+// It returns None if the value at the root does not match.
+func Filter[T any](predicate func(T) bool, t Tree[T]) option.Option[Tree[T]] {
+	if !predicate(t.Value) {
+		return option.None[Tree[T]]()
+	}
+	var children []Tree[T]
+	for _, c := range t.Children {
+		if f := Filter(predicate, c); f.IsSome() {
+			children = append(children, f.Value())
+		}
+	}
+	return option.Some(New(t.Value, children...))
+}
+
+// PreOrder returns the values with each parent before its children.
+func PreOrder[T any](t Tree[T]) []T {
+	var output []T
+	var visit func(t Tree[T])
+	visit = func(t Tree[T]) {
+		output = append(output, t.Value)
+		list.Iter(visit, t.Children)
+	}
+	visit(t)
+	return output
+}
+
+// PostOrder returns the values with each parent after its children.
+func PostOrder[T any](t Tree[T]) []T {
+	var output []T
+	var visit func(t Tree[T])
+	visit = func(t Tree[T]) {
+		list.Iter(visit, t.Children)
+		output = append(output, t.Value)
+	}
+	visit(t)
+	return output
+}
+
+// LevelOrder returns the values one level at a time, starting with the root.
+func LevelOrder[T any](t Tree[T]) []T {
+	var output []T
+	queue := collections.DequeFrom([]Tree[T]{t})
+	for !queue.IsEmpty() {
+		n, _ := queue.PopFront()
+		output = append(output, n.Value)
+		list.Iter(queue.PushBack, n.Children)
+	}
+	return output
+}
+
+// Levels returns the values grouped by their depth, starting with the root.
+func Levels[T any](t Tree[T]) [][]T {
+	var output [][]T
+	level := []Tree[T]{t}
+	for len(level) > 0 {
+		output = append(output, list.Map(func(n Tree[T]) T { return n.Value }, level))
+		level = list.Collect(func(n Tree[T]) []Tree[T] { return n.Children }, level)
+	}
+	return output
+}
+
+// Flatten returns the values of each tree in pre-order, one tree after another.
+// Each value comes after its parent, which is the order FromParentLinks needs to rebuild the trees.
+func Flatten[T any](trees ...Tree[T]) []T {
+	return list.Collect(PreOrder[T], trees)
+}
+
+// Find returns the values on the path from the root to the first value in pre-order that matches predicate,
+// including both ends, or None if no value matches.
+func Find[T any](predicate func(T) bool, t Tree[T]) option.Option[[]T] {
+	var path []T
+	var find func(t Tree[T]) bool
+	find = func(t Tree[T]) bool {
+		path = append(path, t.Value)
+		if predicate(t.Value) {
+			return true
+		}
+		for _, c := range t.Children {
+			if find(c) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if find(t) {
+		return option.Some(path)
+	}
+	return option.None[[]T]()
+}
+
+// FindAll returns the paths from the root to every value that matches predicate in pre-order.
+func FindAll[T any](predicate func(T) bool, t Tree[T]) [][]T {
+	var output [][]T
+	var find func(t Tree[T], path []T)
+	find = func(t Tree[T], path []T) {
+		path = append(path, t.Value)
+		if predicate(t.Value) {
+			output = append(output, append([]T(nil), path...))
+		}
+		for _, c := range t.Children {
+			find(c, path)
+		}
+	}
+	find(t, nil)
+	return output
+}
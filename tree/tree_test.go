@@ -0,0 +1,132 @@
+package tree_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flowonyx/functional/tree"
+)
+
+func menu() tree.Tree[string] {
+	return tree.New("menu",
+		tree.New("file",
+			tree.Leaves("new", "open", "save")...),
+		tree.New("edit",
+			tree.New("copy"),
+			tree.New("find", tree.Leaves("next", "previous")...)),
+		tree.New("help"),
+	)
+}
+
+func ExampleTree_String() {
+	fmt.Print(menu())
+	// Output:
+	// menu
+	// ├── file
+	// │   ├── new
+	// │   ├── open
+	// │   └── save
+	// ├── edit
+	// │   ├── copy
+	// │   └── find
+	// │       ├── next
+	// │       └── previous
+	// └── help
+}
+
+func ExampleSprint() {
+	t := tree.New(1, tree.New(2, tree.New(3)), tree.New(4))
+	fmt.Print(tree.Sprint(func(n int) string { return fmt.Sprintf("#%d", n) }, t))
+	// Output:
+	// #1
+	// ├── #2
+	// │   └── #3
+	// └── #4
+}
+
+func ExampleTree_Size() {
+	t := menu()
+	fmt.Println(t.Size(), t.Height(), t.IsLeaf(), t.Children[2].IsLeaf())
+	// Output: 11 4 false true
+}
+
+func ExampleMap() {
+	t := tree.Map(strings.ToUpper, menu())
+	fmt.Println(tree.PreOrder(t))
+	// Output: [MENU FILE NEW OPEN SAVE EDIT COPY FIND NEXT PREVIOUS HELP]
+}
+
+func ExampleMapWithDepth() {
+	t := tree.MapWithDepth(func(depth int, s string) string { return strings.Repeat("-", depth) + s }, menu())
+	fmt.Println(tree.PreOrder(t)[:4])
+	// Output: [menu -file --new --open]
+}
+
+func ExampleFold() {
+	longest := tree.Fold(func(longest, s string) string {
+		if len(s) > len(longest) {
+			return s
+		}
+		return longest
+	}, "", menu())
+	fmt.Println(longest)
+	// Output: previous
+}
+
+func ExampleFoldUp() {
+	// Count the leaves under each node.
+	leaves := tree.FoldUp(func(s string, children []tree.Tree[int]) tree.Tree[int] {
+		if len(children) == 0 {
+			return tree.New(1)
+		}
+		sum := 0
+		for _, c := range children {
+			sum += c.Value
+		}
+		return tree.New(sum, children...)
+	}, menu())
+	fmt.Println(tree.PreOrder(leaves))
+	// Output: [7 3 1 1 1 3 1 2 1 1 1]
+}
+
+func ExampleFilter() {
+	short := tree.Filter(func(s string) bool { return len(s) <= 4 }, menu())
+	fmt.Print(short.Value())
+	fmt.Println(tree.Filter(func(s string) bool { return s != "menu" }, menu()).IsNone())
+	// Output:
+	// menu
+	// ├── file
+	// │   ├── new
+	// │   ├── open
+	// │   └── save
+	// ├── edit
+	// │   ├── copy
+	// │   └── find
+	// │       └── next
+	// └── help
+	// true
+}
+
+func ExamplePreOrder() {
+	t := menu()
+	fmt.Println(tree.PreOrder(t.Children[1]))
+	fmt.Println(tree.PostOrder(t.Children[1]))
+	fmt.Println(tree.LevelOrder(t.Children[1]))
+	fmt.Println(tree.Levels(t.Children[1]))
+	// Output:
+	// [edit copy find next previous]
+	// [copy next previous find edit]
+	// [edit copy find next previous]
+	// [[edit] [copy find] [next previous]]
+}
+
+func ExampleFind() {
+	t := menu()
+	fmt.Println(tree.Find(func(s string) bool { return s == "previous" }, t))
+	fmt.Println(tree.Find(func(s string) bool { return s == "quit" }, t))
+	fmt.Println(tree.FindAll(func(s string) bool { return strings.HasPrefix(s, "n") }, t))
+	// Output:
+	// Some([menu edit find previous])
+	// None
+	// [[menu file new] [menu edit find next]]
+}
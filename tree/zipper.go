@@ -0,0 +1,214 @@
+package tree
+
+import "github.com/flowonyx/functional/option"
+
+// crumb holds what is needed to rebuild the parent of the focus of a Zipper.
+type crumb[T any] struct {
+	value T
+	left  []Tree[T]
+	right []Tree[T]
+}
+
+// Zipper is a position in a Tree that can be moved around and edited without changing the original Tree.
+// Each move or edit returns a new Zipper, and ToTree returns the whole Tree with the edits made.
+// Moves that are not possible, such as going Up from the root, return None.
+type Zipper[T any] struct {
+	focus  Tree[T]
+	crumbs []crumb[T]
+}
+
+// NewZipper creates a Zipper at the root of t.
+func NewZipper[T any](t Tree[T]) Zipper[T] {
+	return Zipper[T]{focus: t}
+}
+
+// Focus returns the Tree at the position of the Zipper.
+func (z Zipper[T]) Focus() Tree[T] {
+	return z.focus
+}
+
+// Value returns the value at the position of the Zipper.
+func (z Zipper[T]) Value() T {
+	return z.focus.Value
+}
+
+// IsRoot tests whether the Zipper is at the root.
+func (z Zipper[T]) IsRoot() bool {
+	return len(z.crumbs) == 0
+}
+
+// Depth returns the number of moves Up it takes to reach the root.
+func (z Zipper[T]) Depth() int {
+	return len(z.crumbs)
+}
+
+// Path returns the index of the child taken at each level to get from the root to the position of the Zipper.
+func (z Zipper[T]) Path() []int {
+	path := make([]int, len(z.crumbs))
+	for i, c := range z.crumbs {
+		path[i] = len(c.left)
+	}
+	return path
+}
+
+func (z Zipper[T]) with(focus Tree[T], crumbs []crumb[T]) Zipper[T] {
+	return Zipper[T]{focus: focus, crumbs: crumbs}
+}
+
+// push adds a crumb without sharing space with other Zippers made from the same one.
+func (z Zipper[T]) push(c crumb[T]) []crumb[T] {
+	return append(z.crumbs[:len(z.crumbs):len(z.crumbs)], c)
+}
+
+// swapTop replaces the last crumb without sharing space with other Zippers made from the same one.
+func (z Zipper[T]) swapTop(c crumb[T]) []crumb[T] {
+	n := len(z.crumbs) - 1
+	return append(z.crumbs[:n:n], c)
+}
+
+func (z Zipper[T]) top() crumb[T] {
+	return z.crumbs[len(z.crumbs)-1]
+}
+
+// Down moves to the child at index, or returns None if there is no such child.
+func (z Zipper[T]) Down(index int) option.Option[Zipper[T]] {
+	children := z.focus.Children
+	if index < 0 || index >= len(children) {
+		return option.None[Zipper[T]]()
+	}
+	c := crumb[T]{value: z.focus.Value, left: children[:index:index], right: children[index+1:]}
+	return option.Some(z.with(children[index], z.push(c)))
+}
+
+// Up moves to the parent, or returns None at the root.
+func (z Zipper[T]) Up() option.Option[Zipper[T]] {
+	if z.IsRoot() {
+		return option.None[Zipper[T]]()
+	}
+	c := z.top()
+	children := make([]Tree[T], 0, len(c.left)+1+len(c.right))
+	children = append(append(append(children, c.left...), z.focus), c.right...)
+	return option.Some(z.with(New(c.value, children...), z.crumbs[:len(z.crumbs)-1]))
+}
+
+// Left moves to the previous sibling, or returns None if there is none.
+func (z Zipper[T]) Left() option.Option[Zipper[T]] {
+	if z.IsRoot() || len(z.top().left) == 0 {
+		return option.None[Zipper[T]]()
+	}
+	c := z.top()
+	n := len(c.left) - 1
+	moved := crumb[T]{value: c.value, left: c.left[:n:n], right: append([]Tree[T]{z.focus}, c.right...)}
+	return option.Some(z.with(c.left[n], z.swapTop(moved)))
+}
+
+// Right moves to the next sibling, or returns None if there is none.
+func (z Zipper[T]) Right() option.Option[Zipper[T]] {
+	if z.IsRoot() || len(z.top().right) == 0 {
+		return option.None[Zipper[T]]()
+	}
+	c := z.top()
+	moved := crumb[T]{value: c.value, left: append(c.left[:len(c.left):len(c.left)], z.focus), right: c.right[1:]}
+	return option.Some(z.with(c.right[0], z.swapTop(moved)))
+}
+
+// Next moves to the next position in pre-order, or returns None at the last one.
+func (z Zipper[T]) Next() option.Option[Zipper[T]] {
+	if d := z.Down(0); d.IsSome() {
+		return d
+	}
+	for {
+		if r := z.Right(); r.IsSome() {
+			return r
+		}
+		up := z.Up()
+		if up.IsNone() {
+			return up
+		}
+		z = up.Value()
+	}
+}
+
+// Find moves to the first position in pre-order within the Tree at the position of the Zipper
+// whose value matches predicate, starting with the current one. It returns None if no value matches.
+func (z Zipper[T]) Find(predicate func(T) bool) option.Option[Zipper[T]] {
+	depth := z.Depth()
+	for {
+		if predicate(z.Value()) {
+			return option.Some(z)
+		}
+		next := z.Next()
+		if next.IsNone() || next.Value().Depth() <= depth {
+			return option.None[Zipper[T]]()
+		}
+		z = next.Value()
+	}
+}
+
+// Root moves to the root.
+func (z Zipper[T]) Root() Zipper[T] {
+	for !z.IsRoot() {
+		z = z.Up().Value()
+	}
+	return z
+}
+
+// ToTree returns the whole Tree with any edits that have been made.
+func (z Zipper[T]) ToTree() Tree[T] {
+	return z.Root().focus
+}
+
+// Set replaces the value at the position of the Zipper, keeping its children.
+func (z Zipper[T]) Set(value T) Zipper[T] {
+	return z.with(New(value, z.focus.Children...), z.crumbs)
+}
+
+// Update replaces the value at the position of the Zipper with the result of update applied to it.
+func (z Zipper[T]) Update(update func(T) T) Zipper[T] {
+	return z.Set(update(z.focus.Value))
+}
+
+// Replace replaces the Tree at the position of the Zipper.
+func (z Zipper[T]) Replace(t Tree[T]) Zipper[T] {
+	return z.with(t, z.crumbs)
+}
+
+// AppendChild adds t as the last child at the position of the Zipper.
+func (z Zipper[T]) AppendChild(t Tree[T]) Zipper[T] {
+	children := append(z.focus.Children[:len(z.focus.Children):len(z.focus.Children)], t)
+	return z.with(New(z.focus.Value, children...), z.crumbs)
+}
+
+// InsertLeft adds t as the sibling just before the position of the Zipper, which stays where it is.
+// It returns None at the root, which cannot have siblings.
+func (z Zipper[T]) InsertLeft(t Tree[T]) option.Option[Zipper[T]] {
+	if z.IsRoot() {
+		return option.None[Zipper[T]]()
+	}
+	c := z.top()
+	c.left = append(c.left[:len(c.left):len(c.left)], t)
+	return option.Some(z.with(z.focus, z.swapTop(c)))
+}
+
+// InsertRight adds t as the sibling just after the position of the Zipper, which stays where it is.
+// It returns None at the root, which cannot have siblings.
+func (z Zipper[T]) InsertRight(t Tree[T]) option.Option[Zipper[T]] {
+	if z.IsRoot() {
+		return option.None[Zipper[T]]()
+	}
+	c := z.top()
+	c.right = append([]Tree[T]{t}, c.right...)
+	return option.Some(z.with(z.focus, z.swapTop(c)))
+}
+
+// Remove removes the Tree at the position of the Zipper and moves to its parent.
+// It returns None at the root.
+func (z Zipper[T]) Remove() option.Option[Zipper[T]] {
+	if z.IsRoot() {
+		return option.None[Zipper[T]]()
+	}
+	c := z.top()
+	children := make([]Tree[T], 0, len(c.left)+len(c.right))
+	children = append(append(children, c.left...), c.right...)
+	return option.Some(z.with(New(c.value, children...), z.crumbs[:len(z.crumbs)-1]))
+}
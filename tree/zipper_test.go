@@ -0,0 +1,80 @@
+package tree_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flowonyx/functional/option"
+	"github.com/flowonyx/functional/tree"
+)
+
+func ExampleZipper() {
+	original := menu()
+	z := tree.NewZipper(original)
+
+	// Rename "find" to "search" and add "replace" after it.
+	find := z.Find(func(s string) bool { return s == "find" }).Value()
+	fmt.Println(find.Path(), find.Depth())
+	z = find.Set("search").InsertRight(tree.New("replace")).Value()
+	// Remove "help".
+	z = z.Up().Value().Right().Value().Remove().Value()
+	fmt.Print(z.ToTree())
+	fmt.Println(original.Size())
+	// Output:
+	// [1 1] 2
+	// menu
+	// ├── file
+	// │   ├── new
+	// │   ├── open
+	// │   └── save
+	// └── edit
+	//     ├── copy
+	//     ├── search
+	//     │   ├── next
+	//     │   └── previous
+	//     └── replace
+	// 11
+}
+
+func ExampleZipper_Next() {
+	z := option.Some(tree.NewZipper(tree.New(1, tree.New(2, tree.New(3)), tree.New(4))))
+	for ; z.IsSome(); z = z.Value().Next() {
+		fmt.Print(z.Value().Value(), " ")
+	}
+	fmt.Println()
+	// Output: 1 2 3 4
+}
+
+func ExampleZipper_AppendChild() {
+	z := tree.NewZipper(tree.New("root"))
+	z = z.AppendChild(tree.New("b")).Down(0).Value()
+	z = z.InsertLeft(tree.New("a")).Value().Update(func(s string) string { return s + "!" })
+	fmt.Println(z.Left().Value().Value(), z.Right().IsNone(), z.Up().Value().Up().IsNone())
+	fmt.Print(z.ToTree())
+	// Output:
+	// a true true
+	// root
+	// ├── a
+	// └── b!
+}
+
+func TestZipperBranchesDoNotShare(t *testing.T) {
+	z := tree.NewZipper(menu()).Down(1).Value()
+	first := z.Down(0).Value().Set("first")
+	second := z.Down(1).Value().Set("second")
+	if got := tree.PreOrder(first.ToTree())[6]; got != "first" {
+		t.Errorf("first edit = %q, want %q", got, "first")
+	}
+	if got := tree.PreOrder(second.ToTree()); got[6] != "copy" || got[7] != "second" {
+		t.Errorf("second edit = %v, want copy then second", got)
+	}
+	if got := tree.PreOrder(menu()); got[6] != "copy" || got[7] != "find" {
+		t.Errorf("original changed: %v", got)
+	}
+	if z.Down(2).IsSome() || z.Down(-1).IsSome() {
+		t.Error("Down outside the children should be None")
+	}
+	if tree.NewZipper(menu()).Remove().IsSome() {
+		t.Error("Remove at the root should be None")
+	}
+}
@@ -0,0 +1,39 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/prop"
+)
+
+// compact tests that every node other than the root either holds a value or is where two or more keys branch.
+func compact[V any](n *node[V], root bool) bool {
+	if !root && !n.hasValue && len(n.children) < 2 {
+		return false
+	}
+	for _, c := range n.children {
+		if c.prefix == "" || !compact(c, false) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDeleteKeepsTrieCompact(t *testing.T) {
+	keys := prop.StringOf(prop.RuneOf("ab"))
+	prop.ForAll(t, prop.SliceOf(prop.PairOf(keys, prop.Bool())), func(ops []functional.Pair[string, bool]) bool {
+		tr := New[string, int]()
+		for i, op := range ops {
+			if op.Second {
+				tr.Insert(op.First, i)
+			} else {
+				tr.Delete(op.First)
+			}
+			if !compact(&tr.root, true) {
+				return false
+			}
+		}
+		return true
+	})
+}
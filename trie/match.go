@@ -0,0 +1,56 @@
+package trie
+
+import (
+	"strings"
+
+	"github.com/flowonyx/functional"
+)
+
+// DefaultSeparator is the separator between segments used by Match when no other is given.
+const DefaultSeparator = "/"
+
+// Match returns every key that matches pattern, with its value, in lexical order.
+// The key and pattern are split into segments by separator, which is DefaultSeparator if not given.
+// A pattern segment of "*" matches any one segment and "**" matches any number of segments, including none.
+// Other segments must be equal. Only keys that start with the part of pattern before its first wildcard are examined.
+func (t *Trie[K, V]) Match(pattern K, separator ...string) []functional.Pair[K, V] {
+	sep := DefaultSeparator
+	if len(separator) > 0 {
+		sep = separator[0]
+	}
+	segments := strings.Split(string(pattern), sep)
+	literal := segments
+	for i, s := range segments {
+		if s == "*" || s == "**" {
+			literal = segments[:i]
+			break
+		}
+	}
+
+	var output []functional.Pair[K, V]
+	t.IterPrefix(K(strings.Join(literal, sep)), func(k K, v V) bool {
+		if matchSegments(segments, strings.Split(string(k), sep)) {
+			output = append(output, functional.PairOf(k, v))
+		}
+		return false
+	})
+	return output
+}
+
+func matchSegments(pattern, key []string) bool {
+	if len(pattern) == 0 {
+		return len(key) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(key); i++ {
+			if matchSegments(pattern[1:], key[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(key) == 0 || (pattern[0] != "*" && pattern[0] != key[0]) {
+		return false
+	}
+	return matchSegments(pattern[1:], key[1:])
+}
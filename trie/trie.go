@@ -0,0 +1,261 @@
+// Package trie provides a radix tree that maps string keys to values and answers prefix queries,
+// such as every key that starts with a prefix or the longest key that is a prefix of a string.
+package trie
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/option"
+)
+
+// node is one node of the tree. The key of a node is the prefixes of every node from the root down to it.
+// A node only exists where keys branch or end, so a chain of single children is stored as one prefix.
+type node[V any] struct {
+	prefix   string
+	value    V
+	hasValue bool
+	// children are sorted by the first byte of their prefix, which no two children share.
+	children []*node[V]
+}
+
+// child returns the index of the child whose prefix starts with b, or the index to insert it at if there is none.
+func (n *node[V]) child(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].prefix[0] >= b })
+	return i, i < len(n.children) && n.children[i].prefix[0] == b
+}
+
+// mergeChild joins a node without a value to its only child.
+func (n *node[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix += c.prefix
+	n.value, n.hasValue, n.children = c.value, c.hasValue, c.children
+}
+
+// Trie maps keys of any string type to values and keeps the keys in lexical (byte) order.
+// Keys that share a prefix share the nodes that hold it, and a run of nodes with only one child is stored as a single node.
+// The zero value is an empty Trie that is ready to use.
+type Trie[K ~string, V any] struct {
+	root node[V]
+	size int
+}
+
+// New creates a Trie holding the given pairs of keys and values.
+func New[K ~string, V any](pairs ...functional.Pair[K, V]) *Trie[K, V] {
+	t := &Trie[K, V]{}
+	for _, p := range pairs {
+		t.Insert(p.First, p.Second)
+	}
+	return t
+}
+
+// Len returns the number of keys.
+func (t *Trie[K, V]) Len() int {
+	return t.size
+}
+
+// Insert sets the value of key and returns the value it replaced, or None if the key was not present.
+func (t *Trie[K, V]) Insert(key K, value V) option.Option[V] {
+	n, s := &t.root, string(key)
+	for s != "" {
+		i, found := n.child(s[0])
+		if !found {
+			leaf := &node[V]{prefix: s, value: value, hasValue: true}
+			n.children = append(n.children, nil)
+			copy(n.children[i+1:], n.children[i:])
+			n.children[i] = leaf
+			t.size++
+			return option.None[V]()
+		}
+		c := n.children[i]
+		l := commonPrefixLen(s, c.prefix)
+		if l < len(c.prefix) {
+			split := &node[V]{prefix: c.prefix[:l], children: []*node[V]{c}}
+			c.prefix = c.prefix[l:]
+			n.children[i] = split
+			c = split
+		}
+		n, s = c, s[l:]
+	}
+	old := option.None[V]()
+	if n.hasValue {
+		old = option.Some(n.value)
+	} else {
+		t.size++
+	}
+	n.value, n.hasValue = value, true
+	return old
+}
+
+// find returns the node holding exactly key, or nil if there is none.
+func (t *Trie[K, V]) find(key K) *node[V] {
+	n, s := &t.root, string(key)
+	for s != "" {
+		i, found := n.child(s[0])
+		if !found || !strings.HasPrefix(s, n.children[i].prefix) {
+			return nil
+		}
+		n, s = n.children[i], s[len(n.children[i].prefix):]
+	}
+	return n
+}
+
+// Get returns the value of key, or None if it is not present.
+func (t *Trie[K, V]) Get(key K) option.Option[V] {
+	if n := t.find(key); n != nil && n.hasValue {
+		return option.Some(n.value)
+	}
+	return option.None[V]()
+}
+
+// Contains tests whether key is present.
+func (t *Trie[K, V]) Contains(key K) bool {
+	return t.Get(key).IsSome()
+}
+
+// Delete removes key and returns its value, or None if it was not present.
+// Nodes that are no longer needed are removed or joined with their only child so the Trie stays compact.
+func (t *Trie[K, V]) Delete(key K) option.Option[V] {
+	var parents []*node[V]
+	n, s := &t.root, string(key)
+	for s != "" {
+		i, found := n.child(s[0])
+		if !found || !strings.HasPrefix(s, n.children[i].prefix) {
+			return option.None[V]()
+		}
+		parents = append(parents, n)
+		n, s = n.children[i], s[len(n.children[i].prefix):]
+	}
+	if !n.hasValue {
+		return option.None[V]()
+	}
+	old := n.value
+	n.value, n.hasValue = *new(V), false
+	t.size--
+
+	if n == &t.root {
+		return option.Some(old)
+	}
+	switch len(n.children) {
+	case 0:
+		parent := parents[len(parents)-1]
+		i, _ := parent.child(n.prefix[0])
+		parent.children = append(parent.children[:i], parent.children[i+1:]...)
+		if parent != &t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return option.Some(old)
+}
+
+// Clear removes every key.
+func (t *Trie[K, V]) Clear() {
+	t.root, t.size = node[V]{}, 0
+}
+
+// walk applies action to every key and value under n in lexical order until action returns true,
+// and reports whether it did. key is the key of n.
+func walk[V any](n *node[V], key string, action func(string, V) bool) bool {
+	if n.hasValue && action(key, n.value) {
+		return true
+	}
+	for _, c := range n.children {
+		if walk(c, key+c.prefix, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// IterPrefix applies action to every key that starts with prefix, and its value, in lexical order
+// until action returns true.
+func (t *Trie[K, V]) IterPrefix(prefix K, action func(K, V) bool) {
+	n, s, key := &t.root, string(prefix), ""
+	for s != "" {
+		i, found := n.child(s[0])
+		if !found {
+			return
+		}
+		c := n.children[i]
+		if !strings.HasPrefix(s, c.prefix) {
+			if !strings.HasPrefix(c.prefix, s) {
+				return
+			}
+			// prefix ends partway through the prefix of c, so every key under c starts with it.
+			s = c.prefix
+		}
+		n, s, key = c, s[len(c.prefix):], key+c.prefix
+	}
+	walk(n, key, func(k string, v V) bool { return action(K(k), v) })
+}
+
+// WithPrefix returns every key that starts with prefix, with its value, in lexical order.
+func (t *Trie[K, V]) WithPrefix(prefix K) []functional.Pair[K, V] {
+	var output []functional.Pair[K, V]
+	t.IterPrefix(prefix, func(k K, v V) bool {
+		output = append(output, functional.PairOf(k, v))
+		return false
+	})
+	return output
+}
+
+// KeysWithPrefix returns every key that starts with prefix in lexical order.
+func (t *Trie[K, V]) KeysWithPrefix(prefix K) []K {
+	var output []K
+	t.IterPrefix(prefix, func(k K, _ V) bool {
+		output = append(output, k)
+		return false
+	})
+	return output
+}
+
+// Iter applies action to every key and its value in lexical order.
+func (t *Trie[K, V]) Iter(action func(K, V)) {
+	t.IterPrefix("", func(k K, v V) bool {
+		action(k, v)
+		return false
+	})
+}
+
+// Keys returns every key in lexical order.
+func (t *Trie[K, V]) Keys() []K {
+	return t.KeysWithPrefix("")
+}
+
+// ToSlice returns every key with its value in lexical order.
+func (t *Trie[K, V]) ToSlice() []functional.Pair[K, V] {
+	return t.WithPrefix("")
+}
+
+// LongestPrefixOf returns the longest key that s starts with, and its value, or None if no key is a prefix of s.
+// This is how a router finds the most specific route for a path.
+func (t *Trie[K, V]) LongestPrefixOf(s K) option.Option[functional.Pair[K, V]] {
+	longest := option.None[functional.Pair[K, V]]()
+	n, rest := &t.root, string(s)
+	for {
+		if n.hasValue {
+			longest = option.Some(functional.PairOf(s[:len(s)-len(rest)], n.value))
+		}
+		if rest == "" {
+			return longest
+		}
+		i, found := n.child(rest[0])
+		if !found || !strings.HasPrefix(rest, n.children[i].prefix) {
+			return longest
+		}
+		n, rest = n.children[i], rest[len(n.children[i].prefix):]
+	}
+}
+
+func commonPrefixLen(a, b string) int {
+	l := min(len(a), len(b))
+	for i := 0; i < l; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return l
+}
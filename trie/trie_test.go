@@ -0,0 +1,136 @@
+package trie_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/prop"
+	"github.com/flowonyx/functional/trie"
+)
+
+func ExampleTrie() {
+	t := trie.New[string, int]()
+	fmt.Println(t.Insert("romane", 1))
+	t.Insert("romanus", 2)
+	t.Insert("romulus", 3)
+	t.Insert("rubens", 4)
+	fmt.Println(t.Insert("romane", 5))
+	fmt.Println(t.Get("romane"), t.Get("rom"), t.Len())
+	fmt.Println(t.Delete("romanus"), t.Delete("romanus"))
+	fmt.Println(t.Keys())
+	// Output:
+	// None
+	// Some(1)
+	// Some(5) None 4
+	// Some(2) None
+	// [romane romulus rubens]
+}
+
+func ExampleTrie_WithPrefix() {
+	t := trie.New(
+		functional.PairOf("tea", 1),
+		functional.PairOf("ten", 2),
+		functional.PairOf("inn", 3),
+		functional.PairOf("to", 4),
+		functional.PairOf("team", 5),
+	)
+	fmt.Println(t.WithPrefix("te"))
+	fmt.Println(t.KeysWithPrefix("tea"), t.KeysWithPrefix("x"))
+	t.IterPrefix("t", func(k string, v int) bool {
+		fmt.Println(k, v)
+		return k == "team"
+	})
+	// Output:
+	// [("tea", 1) ("team", 5) ("ten", 2)]
+	// [tea team] []
+	// tea 1
+	// team 5
+}
+
+type route string
+
+func ExampleTrie_LongestPrefixOf() {
+	routes := trie.New(
+		functional.PairOf(route("/"), "home"),
+		functional.PairOf(route("/static/"), "files"),
+		functional.PairOf(route("/static/img/"), "images"),
+	)
+	fmt.Println(routes.LongestPrefixOf("/static/img/logo.png"))
+	fmt.Println(routes.LongestPrefixOf("/static/app.js"))
+	fmt.Println(routes.LongestPrefixOf("/about"))
+	fmt.Println(routes.LongestPrefixOf("about"))
+	// Output:
+	// Some((/static/img/, "images"))
+	// Some((/static/, "files"))
+	// Some((/, "home"))
+	// None
+}
+
+func ExampleTrie_Match() {
+	t := trie.New(
+		functional.PairOf("/users/1/posts", 1),
+		functional.PairOf("/users/2/posts", 2),
+		functional.PairOf("/users/2/posts/7", 3),
+		functional.PairOf("/users/2/friends", 4),
+		functional.PairOf("/groups/1/posts", 5),
+	)
+	fmt.Println(t.Match("/users/*/posts"))
+	fmt.Println(t.Match("/users/2/**"))
+	fmt.Println(t.Match("/*/1/posts"))
+	fmt.Println(t.Match("example.com", "."))
+	// Output:
+	// [("/users/1/posts", 1) ("/users/2/posts", 2)]
+	// [("/users/2/friends", 4) ("/users/2/posts", 2) ("/users/2/posts/7", 3)]
+	// [("/groups/1/posts", 5) ("/users/1/posts", 1)]
+	// []
+}
+
+func TestTrieMatchesMap(t *testing.T) {
+	keys := prop.StringOf(prop.RuneOf("abc"))
+	ops := prop.SliceOf(prop.PairOf(keys, prop.Bool()))
+	prop.ForAll(t, ops, func(ops []functional.Pair[string, bool]) bool {
+		tr := trie.New[string, int]()
+		m := map[string]int{}
+		for i, op := range ops {
+			if op.Second {
+				old, had := m[op.First]
+				got := tr.Insert(op.First, i)
+				if got.IsSome() != had || (had && got.Value() != old) {
+					return false
+				}
+				m[op.First] = i
+			} else {
+				old, had := m[op.First]
+				got := tr.Delete(op.First)
+				if got.IsSome() != had || (had && got.Value() != old) {
+					return false
+				}
+				delete(m, op.First)
+			}
+		}
+		want := make([]string, 0, len(m))
+		for k := range m {
+			want = append(want, k)
+		}
+		sort.Strings(want)
+		if tr.Len() != len(m) || !list.Equal(tr.Keys(), want) {
+			return false
+		}
+		for _, prefix := range []string{"", "a", "ab", "ba", "abc"} {
+			wantPrefix := list.Filter(func(k string) bool { return strings.HasPrefix(k, prefix) }, want...)
+			if !list.Equal(tr.KeysWithPrefix(prefix), wantPrefix) {
+				return false
+			}
+		}
+		for k, v := range m {
+			if got := tr.Get(k); got.IsNone() || got.Value() != v {
+				return false
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,124 @@
+package functional
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/result"
+)
+
+// PanicError is the error returned when a panic is recovered by Try and the other functions here.
+// If the panic value was an error, Is and As will match it.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack of the goroutine at the time it panicked.
+	Stack []byte
+}
+
+func newPanicError(v any) *PanicError {
+	return &PanicError{Value: v, Stack: debug.Stack()}
+}
+
+// Error returns "panic: " followed by the panic value.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Try calls f and returns its value as a Success.
+// If f panics, it returns a Failure holding a *PanicError.
+func Try[T any](f func() T) (r result.Result[T, error]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = result.Failure[T, error](newPanicError(v))
+		}
+	}()
+	return result.Success[T, error](f())
+}
+
+// Try_1 takes a function that returns a value and an error and returns a function that returns a Result instead.
+// Both the error and any panic in f are returned as a Failure.
+// It is the opposite of Must_1.
+func Try_1[T, R any](f func(T) (R, error)) func(T) result.Result[R, error] {
+	return func(t T) result.Result[R, error] {
+		return result.Flatten(Try(func() result.Result[R, error] {
+			v, err := f(t)
+			if err != nil {
+				return result.Failure[R](err)
+			}
+			return result.Success[R, error](v)
+		}))
+	}
+}
+
+// Recover recovers from a panic and stores it in *err as a *PanicError,
+// joined with any error that was already there.
+// It must be deferred directly:
+//
+//	defer functional.Recover(&err)
+func Recover(err *error) {
+	if v := recover(); v != nil {
+		*err = errors.Join(*err, newPanicError(v))
+	}
+}
+
+// RecoverWith recovers from a panic and passes it to handler as a *PanicError.
+// It is meant to be deferred at the top of a goroutine so a panic is reported instead of crashing the program:
+//
+//	go func() {
+//		defer functional.RecoverWith(logPanic)
+//		...
+//	}()
+func RecoverWith(handler func(error)) {
+	if v := recover(); v != nil {
+		handler(newPanicError(v))
+	}
+}
+
+// Go runs f in a new goroutine and returns a channel that receives the error f returns,
+// or a *PanicError if f panics, and is then closed.
+func Go(f func() error) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() {
+			done <- err
+			close(done)
+		}()
+		defer Recover(&err)
+		err = f()
+	}()
+	return done
+}
+
+// Finally calls f and then cleanup, even if f panics.
+// A panic in f is returned as a Failure holding a *PanicError.
+func Finally[T any](cleanup func(), f func() T) result.Result[T, error] {
+	defer cleanup()
+	return Try(f)
+}
+
+// Ensure calls f and then cleanup, even if f fails or panics, and returns the value of f as a Result.
+// The Failure holds the error from f, the error from cleanup, or both joined together.
+// It is useful for closing resources that can fail to close:
+//
+//	r := functional.Ensure(file.Close, func() ([]byte, error) { return io.ReadAll(file) })
+func Ensure[T any](cleanup func() error, f func() (T, error)) result.Result[T, error] {
+	r := Try_1(func(struct{}) (T, error) { return f() })(struct{}{})
+	if err := cleanup(); err != nil {
+		if r.IsSuccess() {
+			return result.Failure[T](err)
+		}
+		return result.Failure[T](errors.Join(r.FailureValue(), err))
+	}
+	return r
+}
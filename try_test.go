@@ -0,0 +1,120 @@
+package functional_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flowonyx/functional"
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+)
+
+func ExampleTry() {
+	r := functional.Try(func() int { return functional.Must(list.Head([]int{})) })
+	var p *functional.PanicError
+	fmt.Println(r.IsFailure(), errors.As(r.FailureValue(), &p))
+	fmt.Println(errors.Is(r.FailureValue(), errors.IndexOutOfRangeErr), len(p.Stack) > 0)
+	fmt.Println(functional.Try(func() int { return 1 }).SuccessValue())
+	// Output:
+	// true true
+	// true true
+	// 1
+}
+
+func ExampleTry_adapter() {
+	parse := functional.Try_1(func(s string) (int, error) {
+		if s == "" {
+			return 0, errors.BadArgument("parse", "s", "must not be empty")
+		}
+		if s == "!" {
+			panic("unexpected !")
+		}
+		return len(s), nil
+	})
+	fmt.Println(parse("abc").SuccessValue())
+	fmt.Println(parse("").FailureValue())
+	fmt.Println(parse("!").FailureValue())
+	// Output:
+	// 3
+	// bad argument: parse(arg=s): must not be empty
+	// panic: unexpected !
+}
+
+func ExampleRecover() {
+	divide := func(a, b int) (q int, err error) {
+		defer functional.Recover(&err)
+		return a / b, nil
+	}
+	_, err := divide(1, 0)
+	fmt.Println(err)
+	// Output: panic: runtime error: integer divide by zero
+}
+
+func ExampleRecoverWith() {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer functional.RecoverWith(func(err error) { fmt.Println("recovered:", err) })
+		panic("boom")
+	}()
+	<-done
+	// Output: recovered: panic: boom
+}
+
+func ExampleGo() {
+	fmt.Println(<-functional.Go(func() error { return nil }))
+	fmt.Println(<-functional.Go(func() error { return errors.NotFound("Load") }))
+	fmt.Println(<-functional.Go(func() error { panic("boom") }))
+	// Output:
+	// <nil>
+	// not found: Load()
+	// panic: boom
+}
+
+func ExampleFinally() {
+	r := functional.Finally(func() { fmt.Println("cleaned up") }, func() int { panic("boom") })
+	fmt.Println(r.FailureValue())
+	// Output:
+	// cleaned up
+	// panic: boom
+}
+
+func ExampleEnsure() {
+	closeErr := errors.New("close failed")
+	readErr := errors.New("read failed")
+	r := functional.Ensure(func() error { return closeErr }, func() (string, error) { return "", readErr })
+	fmt.Println(r.FailureValue())
+	fmt.Println(errors.Is(r.FailureValue(), closeErr), errors.Is(r.FailureValue(), readErr))
+
+	r = functional.Ensure(func() error { return nil }, func() (string, error) { return "data", nil })
+	fmt.Println(r.SuccessValue())
+	// Output:
+	// read failed; close failed
+	// true true
+	// data
+}
+
+func ExampleMust3() {
+	f := func() (int, string, bool, error) { return 1, "a", true, nil }
+	fmt.Println(functional.Must3(f()))
+	// Output: 1 a true
+}
+
+func ExampleMust5() {
+	f := func() (int, int, int, int, int, error) { return 1, 2, 3, 4, 5, nil }
+	fmt.Println(functional.Must5(f()))
+	// Output: 1 2 3 4 5
+}
+
+func ExampleMustOption() {
+	fmt.Println(functional.MustOption(list.TryItem(1, []string{"a", "b"})))
+	r := functional.Try(func() string {
+		return functional.MustOption(list.TryItem(2, []string{"a", "b"}), "no second argument")
+	})
+	fmt.Println(strings.TrimPrefix(r.FailureValue().Error(), "panic: "))
+	fmt.Println(errors.Is(r.FailureValue(), errors.NotFoundErr))
+	// Output:
+	// b
+	// not found: MustOption(type=string): no second argument
+	// true
+}
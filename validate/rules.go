@@ -0,0 +1,335 @@
+package validate
+
+import (
+	"fmt"
+	"math/big"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/flowonyx/functional/list"
+)
+
+// Email creates a Validator that accepts only a bare address such as "bob@example.com".
+// Display names ("Bob <bob@example.com>"), comments and domains without a dot are rejected.
+// Use EmailLenient to accept anything that net/mail can parse.
+func Email() Validator {
+	return Rule("email", func(s string) string {
+		local, domain, found := strings.Cut(s, "@")
+		if !found {
+			return "missing @"
+		}
+		if strings.Contains(domain, "@") {
+			return "more than one @"
+		}
+		if local == "" || len(local) > 64 {
+			return "local part must be between 1 and 64 characters"
+		}
+		if strings.HasPrefix(local, ".") || strings.HasSuffix(local, ".") || strings.Contains(local, "..") {
+			return "local part has a misplaced dot"
+		}
+		for _, r := range local {
+			if !isAtext(r) && r != '.' {
+				return fmt.Sprintf("local part contains invalid character %q", r)
+			}
+		}
+		if reason := checkHostname(domain); reason != "" {
+			return "domain " + reason
+		}
+		if !strings.Contains(strings.TrimSuffix(domain, "."), ".") {
+			return "domain must contain a dot"
+		}
+		return ""
+	})
+}
+
+// isAtext reports whether r is allowed unquoted in the local part of an address (RFC 5322).
+func isAtext(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r))
+}
+
+// EmailLenient creates a Validator that accepts anything net/mail can parse as a single address,
+// including display names such as "Bob <bob@example.com>".
+func EmailLenient() Validator {
+	return Rule("email", func(s string) string {
+		if _, err := mail.ParseAddress(s); err != nil {
+			return err.Error()
+		}
+		return ""
+	})
+}
+
+// URL creates a Validator that accepts absolute URLs with a host.
+// If schemes are given, the scheme must be one of them (compared case-insensitively).
+func URL(schemes ...string) Validator {
+	return Rule("url", func(s string) string {
+		u, err := url.Parse(s)
+		if err != nil {
+			return err.(*url.Error).Err.Error()
+		}
+		if u.Scheme == "" {
+			return "missing scheme"
+		}
+		if u.Host == "" {
+			return "missing host"
+		}
+		if len(schemes) > 0 && !list.Exists(func(scheme string) bool { return strings.EqualFold(scheme, u.Scheme) }, schemes...) {
+			return fmt.Sprintf("scheme %q is not one of %v", u.Scheme, schemes)
+		}
+		return ""
+	})
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// UUID creates a Validator that accepts UUIDs in the canonical 8-4-4-4-12 hexadecimal form.
+func UUID() Validator {
+	return Rule("uuid", func(s string) string {
+		if !uuidPattern.MatchString(s) {
+			return "must be 32 hexadecimal digits in the form 8-4-4-4-12"
+		}
+		return ""
+	})
+}
+
+// IP creates a Validator that accepts IPv4 or IPv6 addresses.
+func IP() Validator {
+	return Rule("ip", func(s string) string {
+		if _, err := netip.ParseAddr(s); err != nil {
+			return err.Error()
+		}
+		return ""
+	})
+}
+
+// IPv4 creates a Validator that accepts only IPv4 addresses.
+func IPv4() Validator {
+	return Rule("ipv4", func(s string) string {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return err.Error()
+		}
+		if !addr.Is4() {
+			return "not an IPv4 address"
+		}
+		return ""
+	})
+}
+
+// IPv6 creates a Validator that accepts only IPv6 addresses.
+func IPv6() Validator {
+	return Rule("ipv6", func(s string) string {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return err.Error()
+		}
+		if !addr.Is6() {
+			return "not an IPv6 address"
+		}
+		return ""
+	})
+}
+
+// CIDR creates a Validator that accepts IP prefixes in CIDR notation such as "10.0.0.0/8".
+func CIDR() Validator {
+	return Rule("cidr", func(s string) string {
+		if _, err := netip.ParsePrefix(s); err != nil {
+			return err.Error()
+		}
+		return ""
+	})
+}
+
+// Hostname creates a Validator that accepts host names according to RFC 1123.
+// A single trailing dot is allowed.
+func Hostname() Validator {
+	return Rule("hostname", checkHostname)
+}
+
+func checkHostname(s string) string {
+	name := strings.TrimSuffix(s, ".")
+	if name == "" {
+		return "must not be empty"
+	}
+	if len(name) > 253 {
+		return "must be at most 253 characters"
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 {
+			return "labels must be between 1 and 63 characters"
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Sprintf("label %q must not begin or end with a hyphen", label)
+		}
+		for _, r := range label {
+			if r >= utf8.RuneSelf || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-') {
+				return fmt.Sprintf("label %q contains invalid character %q", label, r)
+			}
+		}
+	}
+	return ""
+}
+
+// semVerPattern is the pattern recommended by semver.org.
+var semVerPattern = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// SemVer creates a Validator that accepts semantic versions such as "1.2.3-beta.1+build.5".
+// A leading "v" is not accepted.
+func SemVer() Validator {
+	return Rule("semver", func(s string) string {
+		if !semVerPattern.MatchString(s) {
+			return "must be MAJOR.MINOR.PATCH with optional pre-release and build metadata"
+		}
+		return ""
+	})
+}
+
+// ISODate creates a Validator that accepts ISO-8601 calendar dates such as "2006-01-02".
+func ISODate() Validator {
+	return Rule("date", func(s string) string {
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			return "must be a valid date in the form YYYY-MM-DD"
+		}
+		return ""
+	})
+}
+
+var isoDateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+}
+
+// ISODateTime creates a Validator that accepts ISO-8601 date-times such as "2006-01-02T15:04:05Z".
+// Seconds, fractional seconds and the time zone offset are optional.
+func ISODateTime() Validator {
+	return Rule("datetime", func(s string) string {
+		valid := list.Exists(func(layout string) bool {
+			_, err := time.Parse(layout, s)
+			return err == nil
+		}, isoDateTimeLayouts...)
+		if !valid {
+			return "must be a valid date and time in the form YYYY-MM-DDThh:mm[:ss][Z|±hh:mm]"
+		}
+		return ""
+	})
+}
+
+// CreditCard creates a Validator that accepts card numbers of 12 to 19 digits that pass the Luhn checksum.
+// Spaces and hyphens between digits are ignored.
+func CreditCard() Validator {
+	return Rule("creditcard", func(s string) string {
+		digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+		if len(digits) < 12 || len(digits) > 19 {
+			return "must have between 12 and 19 digits"
+		}
+		sum := 0
+		for i := range digits {
+			d := digits[len(digits)-1-i]
+			if d < '0' || d > '9' {
+				return "must contain only digits"
+			}
+			n := int(d - '0')
+			if i%2 == 1 {
+				n *= 2
+				if n > 9 {
+					n -= 9
+				}
+			}
+			sum += n
+		}
+		if sum%10 != 0 {
+			return "failed the Luhn checksum"
+		}
+		return ""
+	})
+}
+
+// IBAN creates a Validator that accepts International Bank Account Numbers with a valid mod-97 check.
+// Spaces are ignored and letters may be either case.
+func IBAN() Validator {
+	return Rule("iban", func(s string) string {
+		iban := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+		if len(iban) < 15 || len(iban) > 34 {
+			return "must have between 15 and 34 characters"
+		}
+		if !isASCIIUpper(iban[0]) || !isASCIIUpper(iban[1]) {
+			return "must begin with a two letter country code"
+		}
+		if !isDigit(iban[2]) || !isDigit(iban[3]) {
+			return "must have two check digits after the country code"
+		}
+		numeric := &strings.Builder{}
+		for _, c := range []byte(iban[4:] + iban[:4]) {
+			switch {
+			case isDigit(c):
+				numeric.WriteByte(c)
+			case isASCIIUpper(c):
+				fmt.Fprintf(numeric, "%d", c-'A'+10)
+			default:
+				return fmt.Sprintf("contains invalid character %q", c)
+			}
+		}
+		n, _ := new(big.Int).SetString(numeric.String(), 10)
+		if new(big.Int).Mod(n, big.NewInt(97)).Int64() != 1 {
+			return "failed the mod-97 checksum"
+		}
+		return ""
+	})
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isASCIIUpper(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// E164 creates a Validator that accepts phone numbers in E.164 format such as "+14155552671".
+func E164() Validator {
+	return Rule("e164", func(s string) string {
+		if !e164Pattern.MatchString(s) {
+			return "must be + followed by up to 15 digits"
+		}
+		return ""
+	})
+}
+
+// MinLength creates a Validator that accepts strings with at least min runes.
+func MinLength(min int) Validator {
+	return Rule("minlength", func(s string) string {
+		if utf8.RuneCountInString(s) < min {
+			return fmt.Sprintf("must be at least %d characters", min)
+		}
+		return ""
+	})
+}
+
+// MaxLength creates a Validator that accepts strings with at most max runes.
+func MaxLength(max int) Validator {
+	return Rule("maxlength", func(s string) string {
+		if utf8.RuneCountInString(s) > max {
+			return fmt.Sprintf("must be at most %d characters", max)
+		}
+		return ""
+	})
+}
+
+// Regexp creates a Validator that accepts strings matching re.
+func Regexp(re *regexp.Regexp) Validator {
+	return Rule("regexp", func(s string) string {
+		if !re.MatchString(s) {
+			return fmt.Sprintf("must match %s", re)
+		}
+		return ""
+	})
+}
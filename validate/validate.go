@@ -0,0 +1,119 @@
+// Package validate provides composable validators for strings.
+// Each validator returns a result.Result that is either the validated string
+// or a ValidationError explaining why the string was rejected.
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/result"
+)
+
+// ValidationError describes why a string failed validation.
+// It wraps BadArgumentErr so it can be checked with errors.Is.
+type ValidationError struct {
+	// Rule is the name of the validator that failed, such as "email".
+	Rule string
+	// Value is the string that failed validation.
+	Value string
+	// Reason explains why Value failed.
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Rule, e.Reason)
+}
+
+// Unwrap returns BadArgumentErr.
+func (e ValidationError) Unwrap() error {
+	return errors.BadArgumentErr
+}
+
+// Validator checks a string and returns it as Success if it is valid
+// or a ValidationError as Failure if it is not.
+type Validator func(string) result.Result[string, ValidationError]
+
+// IsValid tests whether s passes the Validator.
+func (v Validator) IsValid(s string) bool {
+	return v(s).IsSuccess()
+}
+
+// Check returns nil if s passes the Validator and the ValidationError otherwise.
+func (v Validator) Check(s string) error {
+	if r := v(s); r.IsFailure() {
+		return r.FailureValue()
+	}
+	return nil
+}
+
+// Predicate converts v into a predicate that can be passed directly to functions such as list.Filter.
+func Predicate[TString ~string](v Validator) func(TString) bool {
+	return func(s TString) bool {
+		return v.IsValid(string(s))
+	}
+}
+
+// Validate applies v to s, converting s to a string first.
+func Validate[TString ~string](v Validator, s TString) result.Result[string, ValidationError] {
+	return v(string(s))
+}
+
+// Rule creates a Validator named rule from a check function.
+// check returns an empty string when s is valid or the reason s is invalid.
+func Rule(rule string, check func(s string) string) Validator {
+	return func(s string) result.Result[string, ValidationError] {
+		if reason := check(s); reason != "" {
+			return fail(rule, s, reason)
+		}
+		return result.Success[string, ValidationError](s)
+	}
+}
+
+func fail(rule, value, reason string) result.Result[string, ValidationError] {
+	return result.Failure[string](ValidationError{Rule: rule, Value: value, Reason: reason})
+}
+
+// And creates a Validator that passes only if all validators pass.
+// The first failure is returned.
+func And(validators ...Validator) Validator {
+	return func(s string) result.Result[string, ValidationError] {
+		for _, v := range validators {
+			if r := v(s); r.IsFailure() {
+				return r
+			}
+		}
+		return result.Success[string, ValidationError](s)
+	}
+}
+
+// Or creates a Validator that passes if any of validators pass.
+// If they all fail, the failure combines the rules and reasons of every validator.
+func Or(validators ...Validator) Validator {
+	return func(s string) result.Result[string, ValidationError] {
+		failures := make([]ValidationError, 0, len(validators))
+		for _, v := range validators {
+			r := v(s)
+			if r.IsSuccess() {
+				return r
+			}
+			failures = append(failures, r.FailureValue())
+		}
+		rules := list.Map(func(e ValidationError) string { return e.Rule }, failures)
+		reasons := list.Map(func(e ValidationError) string { return e.Error() }, failures)
+		return fail(strings.Join(rules, "|"), s, strings.Join(reasons, "; "))
+	}
+}
+
+// Not creates a Validator that passes only if v fails.
+// The failure is reported under the name rule.
+func Not(rule string, v Validator) Validator {
+	return func(s string) result.Result[string, ValidationError] {
+		if v.IsValid(s) {
+			return fail(rule, s, "is not allowed")
+		}
+		return result.Success[string, ValidationError](s)
+	}
+}
@@ -0,0 +1,110 @@
+package validate_test
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/flowonyx/functional/errors"
+	"github.com/flowonyx/functional/list"
+	"github.com/flowonyx/functional/validate"
+)
+
+func ExampleEmail() {
+	v := validate.Email()
+	fmt.Println(v("bob@example.com").SuccessValue())
+	r := v("Bob <bob@example.com>")
+	fmt.Println(r.FailureValue())
+	fmt.Println(validate.EmailLenient().IsValid("Bob <bob@example.com>"))
+	// Output:
+	// bob@example.com
+	// email: local part contains invalid character ' '
+	// true
+}
+
+func ExampleValidationError() {
+	err := validate.UUID().Check("not-a-uuid")
+	var verr validate.ValidationError
+	fmt.Println(errors.As(err, &verr), verr.Rule, verr.Value, errors.Is(err, errors.BadArgumentErr))
+	// Output: true uuid not-a-uuid true
+}
+
+func ExampleURL() {
+	v := validate.URL("https")
+	fmt.Println(v.Check("https://example.com/path"))
+	fmt.Println(v.Check("http://example.com"))
+	fmt.Println(v.Check("/relative"))
+	// Output:
+	// <nil>
+	// url: scheme "http" is not one of [https]
+	// url: missing scheme
+}
+
+func ExampleIP() {
+	fmt.Println(validate.IP().IsValid("::1"), validate.IPv4().IsValid("::1"), validate.IPv6().IsValid("::1"), validate.CIDR().IsValid("10.0.0.0/8"))
+	// Output: true false true true
+}
+
+func ExampleHostname() {
+	fmt.Println(validate.Hostname().IsValid("api.example.com"), validate.Hostname().Check("-bad.example.com"))
+	// Output: true hostname: label "-bad" must not begin or end with a hyphen
+}
+
+func ExampleSemVer() {
+	fmt.Println(validate.SemVer().IsValid("1.2.3-beta.1+build.5"), validate.SemVer().IsValid("1.02.3"))
+	// Output: true false
+}
+
+func ExampleISODate() {
+	fmt.Println(validate.ISODate().IsValid("2024-02-29"), validate.ISODate().IsValid("2023-02-29"))
+	fmt.Println(validate.ISODateTime().IsValid("2024-02-29T13:45:00+01:00"), validate.ISODateTime().IsValid("2024-02-29T13:45"), validate.ISODateTime().IsValid("2024-02-29"))
+	// Output:
+	// true false
+	// true true false
+}
+
+func ExampleCreditCard() {
+	fmt.Println(validate.CreditCard().IsValid("4111 1111 1111 1111"), validate.CreditCard().Check("4111 1111 1111 1112"))
+	// Output: true creditcard: failed the Luhn checksum
+}
+
+func ExampleIBAN() {
+	fmt.Println(validate.IBAN().IsValid("GB82 WEST 1234 5698 7654 32"), validate.IBAN().Check("GB82 WEST 1234 5698 7654 33"))
+	// Output: true iban: failed the mod-97 checksum
+}
+
+func ExampleE164() {
+	fmt.Println(validate.E164().IsValid("+14155552671"), validate.E164().IsValid("4155552671"))
+	// Output: true false
+}
+
+func ExampleAnd() {
+	username := validate.And(validate.MinLength(3), validate.MaxLength(8), validate.Regexp(regexp.MustCompile(`^[a-z]+$`)))
+	fmt.Println(username.Check("bob"))
+	fmt.Println(username.Check("bo"))
+	fmt.Println(username.Check("Bobby"))
+	// Output:
+	// <nil>
+	// minlength: must be at least 3 characters
+	// regexp: must match ^[a-z]+$
+}
+
+func ExampleOr() {
+	host := validate.Or(validate.IP(), validate.Hostname())
+	fmt.Println(host.IsValid("10.0.0.1"), host.IsValid("example.com"))
+	fmt.Println(host("bad_host").FailureValue().Rule)
+	// Output:
+	// true true
+	// ip|hostname
+}
+
+func ExampleNot() {
+	notIP := validate.Not("not-ip", validate.IP())
+	fmt.Println(notIP.Check("example.com"), notIP.Check("10.0.0.1"))
+	// Output: <nil> not-ip: is not allowed
+}
+
+func ExamplePredicate() {
+	inputs := []string{"bob@example.com", "nope", "ann@example.org"}
+	fmt.Println(list.Filter(validate.Predicate[string](validate.Email()), inputs...))
+	// Output: [bob@example.com ann@example.org]
+}